@@ -7,21 +7,31 @@ import (
 	"os/signal"
 	"sync/atomic"
 	"syscall"
+	"task-processor/internal/application/usecases/schedule"
 	"task-processor/internal/application/usecases/task"
+	"task-processor/internal/application/usecases/task/backoff"
+	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/adapters/inbound/httpserver"
+	"task-processor/internal/infrastructure/adapters/inbound/jobs"
 	"task-processor/internal/infrastructure/adapters/inbound/random"
 	"task-processor/internal/infrastructure/adapters/inbound/tasksprocessor"
+	"task-processor/internal/infrastructure/adapters/inbound/worker"
 	"task-processor/internal/infrastructure/adapters/outbound/postgres"
 	"task-processor/internal/infrastructure/adapters/outbound/redis"
+	"task-processor/internal/infrastructure/adapters/outbound/taskhandler"
+	"task-processor/internal/infrastructure/adapters/outbound/taskstream"
+	"task-processor/internal/infrastructure/adapters/outbound/webhook"
 	"task-processor/internal/infrastructure/config"
 	"task-processor/internal/infrastructure/constructor"
+	"task-processor/internal/infrastructure/shared/lifecycle"
 	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
+	"task-processor/internal/infrastructure/shared/tracing"
 	"task-processor/internal/infrastructure/shared/validator"
 	"time"
 
 	"github.com/gammazero/workerpool"
 	"github.com/go-chi/chi/v5"
-	"github.com/oklog/run"
 	"go.uber.org/zap"
 
 	_ "task-processor/internal/infrastructure/adapters/inbound/httpserver/docs"
@@ -38,8 +48,6 @@ func main() {
 }
 
 func runApp() error {
-	var g run.Group
-
 	// --- Root context with OS signals ---
 	rootCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
@@ -52,6 +60,22 @@ func runApp() error {
 	cfg := config.GetConfig()
 	
 	log := logger.GetLogger()
+
+	appMetrics := metrics.GetMetrics()
+
+	// --- Init tracing ---
+	shutdownTracing, err := tracing.Setup(rootCtx, cfg)
+	if err != nil {
+		return fmt.Errorf("tracing.Setup failed: %w", err)
+	}
+	defer func() {
+		// Flush spans still buffered by the batch exporter
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Shutdown.HTTPTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Error("failed to flush traces", zap.Error(err))
+		}
+	}()
 	
 	// --- Init Postgres & Redis ---
 	log.Info("initializing postgresql storage")
@@ -68,12 +92,97 @@ func runApp() error {
 	}
 	defer rdb.Close()
 
+	// --- Init task handlers ---
+	randomProvider := random.NewCryptoRandomProvider()
+	handlers := taskhandler.NewRegistry()
+	handlers.Register(taskhandler.SimulatedTaskType, taskhandler.NewSimulatedHandler(randomProvider))
+
 	// --- Init task usecases ---
+	retryBackoff := backoff.Config{
+		Base:       cfg.Retry.BackoffBase,
+		Multiplier: cfg.Retry.BackoffMultiplier,
+		Cap:        cfg.Retry.BackoffCap,
+		Jitter:     backoff.Jitter(cfg.Retry.BackoffJitter),
+	}
+	if err := retryBackoff.Validate(); err != nil {
+		return fmt.Errorf("invalid retry backoff config: %w", err)
+	}
+	if cfg.Webhook.Enabled && cfg.Webhook.Secret == "" {
+		return fmt.Errorf("WEBHOOK_SECRET is required when webhooks are enabled")
+	}
+	eventSink, err := newEventSink(cfg.Outbox, rdb.Client())
+	if err != nil {
+		return fmt.Errorf("outboxsink.New failed: %w", err)
+	}
+	if cfg.TaskStream.KeepAliveInterval <= 0 {
+		return fmt.Errorf("TASK_STREAM_KEEPALIVE_INTERVAL must be positive")
+	}
+	streamHub := taskstream.NewHub(cfg.TaskStream.BufferSize, cfg.TaskStream.SubscriberBuffer)
+
 	taskUseCases := task.NewUseCases(
 		store.TaskRepo,
 		store.FailedTaskRepo,
+		store.TaskEventRepo,
+		store.TaskAttemptRepo,
+		store.WebhookRepo,
+		store.OutboxRepo,
+		store.ProcessingRunRepo,
 		store.TxManager,
-		random.NewCryptoRandomProvider(),
+		store.Locker,
+		randomProvider,
+		handlers,
+		webhook.NewHTTPSender(cfg.Webhook.Secret, cfg.Webhook.Timeout, cfg.Webhook.AllowedNetworks),
+		eventSink,
+		streamHub,
+		task.Settings{
+			Lease: domain.Lease{
+				Owner:    cfg.App.Identity(),
+				Duration: cfg.Lease.Duration,
+			},
+			ReaperBatchSize: cfg.Lease.ReaperBatchSize,
+			ReleaseTimeout:  cfg.Lease.ReleaseTimeout,
+			RetryBackoff:    retryBackoff,
+			SweeperBatchSize: cfg.Sweeper.BatchSize,
+			StatsCacheTTL:    cfg.Stats.CacheTTL,
+			EventRetention:      cfg.TaskEvents.Retention,
+			EventPruneBatchSize: cfg.TaskEvents.PruneBatchSize,
+			Webhooks: task.WebhookSettings{
+				Enabled:     cfg.Webhook.Enabled,
+				TypeURLs:    cfg.Webhook.TypeURLs,
+				BatchSize:   cfg.Webhook.BatchSize,
+				MaxAttempts: cfg.Webhook.MaxAttempts,
+				ClaimLease:  cfg.Webhook.ClaimLease,
+				RetryBackoff: backoff.Config{
+					Base:       cfg.Webhook.BackoffBase,
+					Multiplier: 2,
+					Cap:        cfg.Webhook.BackoffCap,
+					Jitter:     backoff.JitterEqual,
+				},
+			},
+			Outbox: task.OutboxSettings{
+				Enabled:    cfg.Outbox.Enabled,
+				BatchSize:  cfg.Outbox.BatchSize,
+				ClaimLease: cfg.Outbox.ClaimLease,
+				Retention:  cfg.Outbox.Retention,
+			},
+			Runs: task.RunSettings{
+				StaleAfter: cfg.ProcessingRun.StaleAfter,
+				Retention:  cfg.ProcessingRun.Retention,
+				BatchSize:  cfg.ProcessingRun.BatchSize,
+			},
+		},
+	)
+
+	// --- Init schedule usecases ---
+	scheduleUseCases := schedule.NewUseCases(
+		store.ScheduleRepo,
+		taskUseCases.Creator,
+		store.TxManager,
+		store.Locker,
+		schedule.Settings{
+			BatchSize:  cfg.Scheduler.BatchSize,
+			MaxCatchUp: cfg.Scheduler.MaxCatchUp,
+		},
 	)
 
 	// --  Init worker pool ---
@@ -81,7 +190,15 @@ func runApp() error {
 	defer wp.StopWait()
 
 	// --- Init concurrent tasks processor ---
-	ccTasksProcessor := tasksprocessor.NewConcurrentTasksProcessor(log, wp, taskUseCases)
+	ccTasksProcessor := tasksprocessor.NewConcurrentTasksProcessor(log, wp, taskUseCases, appMetrics)
+
+	// --- Expose pool gauges ---
+	if err := appMetrics.RegisterWorkerPool(wp); err != nil {
+		return fmt.Errorf("failed to register worker pool metrics: %w", err)
+	}
+	if err := appMetrics.RegisterPgxPool(store.Pool()); err != nil {
+		return fmt.Errorf("failed to register postgres pool metrics: %w", err)
+	}
 
 	// --- Init & Construct chi-router ---
 	router := chi.NewRouter()
@@ -92,10 +209,13 @@ func runApp() error {
 			PG:        store,
 			Redis:     rdb.Client(),
 			Validator: validator.New(),
+			Metrics:   appMetrics,
 		},
 		App: constructor.AppDeps{
 			TaskUseCases: 	 taskUseCases,
+			ScheduleUseCases: scheduleUseCases,
 			TasksProcessor:  ccTasksProcessor,
+			TaskStream:      streamHub,
 			IsShuttingDown:  &isShuttingDown,
 		},
 	}
@@ -104,24 +224,82 @@ func runApp() error {
 	// --- Init HTTP server ---
 	httpSrv := httpserver.NewHTTPServer(cfg, router, ongoingCtx)
 
-	// --- OS signal listener (SIGINT, SIGTERM) ---
-	g.Add(
-		func() error {
-			// Wait for termination signal (e.g., Ctrl+C or Kubernetes pod shutdown)
-			<-rootCtx.Done()
-			// Returning nil will trigger the shutdown sequence in other run.Group actors
-			return nil
-		},
-		func(err error) {
-			// Cleanup logic for the signal handler
+	// --- Shutdown sequence on SIGINT/SIGTERM (e.g., Ctrl+C or Kubernetes pod shutdown) ---
+	// Tasks in flight finish or are released before the worker stops and HTTP requests are cancelled
+	g := lifecycle.NewGroup(
+		rootCtx,
+		func() {
 			stop()
 			isShuttingDown.Store(true)
 			log.Info("shutdown signal received")
 			// Allow time for readiness probe updates to propagate to external load balancers
 			time.Sleep(cfg.Shutdown.ReadinessDrain)
 		},
+		func() {
+			ccTasksProcessor.Drain(cfg.Shutdown.TaskDrainTimeout, cfg.Lease.ReleaseTimeout)
+		},
 	)
 
+	// --- Expired lease reaper ---
+	leaseReaper := jobs.NewPeriodicJob(log, "lease-reaper", cfg.Lease.ReaperInterval, taskUseCases.Reaper.ReleaseExpired)
+	g.Add(leaseReaper.Run, leaseReaper.Stop)
+
+	// --- Processing runs left running by a stopped instance ---
+	runReaper := jobs.NewPeriodicJob(log, "run-reaper", cfg.ProcessingRun.ReaperInterval, taskUseCases.ProcessingRuns.FailOrphaned)
+	g.Add(runReaper.Run, runReaper.Stop)
+
+	// --- Retention of finished processing runs ---
+	runPruner := jobs.NewPeriodicJob(log, "run-pruner", cfg.ProcessingRun.PruneInterval, taskUseCases.ProcessingRuns.PruneFinished)
+	g.Add(runPruner.Run, runPruner.Stop)
+
+	// --- Dead-letter sweeper for exhausted tasks ---
+	sweepExhausted := func(ctx context.Context) (int, error) {
+		moved, err := taskUseCases.Sweeper.SweepExhausted(ctx)
+		appMetrics.TasksDeadLettered.WithLabelValues("sweeper").Add(float64(moved))
+		return moved, err
+	}
+	dlqSweeper := jobs.NewPeriodicJob(log, "dlq-sweeper", cfg.Sweeper.Interval, sweepExhausted)
+	g.Add(dlqSweeper.Run, dlqSweeper.Stop)
+
+	// --- Retention of the task event history ---
+	eventPruner := jobs.NewPeriodicJob(log, "event-pruner", cfg.TaskEvents.PruneInterval, taskUseCases.EventPruner.PruneExpired)
+	g.Add(eventPruner.Run, eventPruner.Stop)
+
+	// --- Completion webhooks, retried until acknowledged ---
+	if cfg.Webhook.Enabled {
+		webhookDispatcher := jobs.NewPeriodicJob(log, "webhook-dispatcher", cfg.Webhook.Interval, taskUseCases.WebhookDispatcher.DeliverDue)
+		g.Add(webhookDispatcher.Run, webhookDispatcher.Stop)
+	}
+
+	// --- Task lifecycle events, relayed from the outbox by one instance at a time ---
+	if cfg.Outbox.Enabled {
+		outboxRelay := jobs.NewPeriodicJob(log, "outbox-relay", cfg.Outbox.Interval, taskUseCases.OutboxRelay.PublishPending)
+		g.Add(outboxRelay.Run, outboxRelay.Stop)
+
+		outboxPruner := jobs.NewPeriodicJob(log, "outbox-pruner", cfg.Outbox.PruneInterval, taskUseCases.OutboxRelay.PrunePublished)
+		g.Add(outboxPruner.Run, outboxPruner.Stop)
+	}
+
+	// --- Recurring schedules, fired by one instance at a time ---
+	if cfg.Scheduler.Enabled {
+		scheduler := jobs.NewPeriodicJob(log, "scheduler", cfg.Scheduler.Interval, scheduleUseCases.Scheduler.RunDue)
+		g.Add(scheduler.Run, scheduler.Stop)
+	}
+
+	// --- Background task worker ---
+	if cfg.Worker.Enabled {
+		var waker worker.Waker
+		if cfg.Worker.ListenEnabled {
+			// Wakes the worker on NOTIFY, polling remains the fallback while it is disconnected
+			listener := postgres.NewListener(store.Pool(), log, cfg.Worker.ListenReconnectInterval)
+			g.Add(listener.Run, listener.Stop)
+			waker = listener
+		}
+
+		taskWorker := worker.NewWorker(log, ccTasksProcessor, cfg.Worker, waker)
+		g.Add(taskWorker.Run, taskWorker.Stop)
+	}
+
 	// --- HTTP server lifecycle ---
 	g.Add(
 		func() error {
@@ -134,6 +312,8 @@ func runApp() error {
 		},
 		func(err error) {
 			log.Info("shutting down http server")
+			// Ends open event streams, which would otherwise hold up the shutdown
+			streamHub.Close()
 			// Begin graceful shutdown process with timeout
 			ctx, cancel := context.WithTimeout(context.Background(), cfg.Shutdown.HTTPTimeout)
 			defer cancel()
@@ -158,4 +338,4 @@ func runApp() error {
 	}
 
 	return nil
-}
+}
\ No newline at end of file
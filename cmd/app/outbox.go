@@ -0,0 +1,18 @@
+package main
+
+import (
+	"task-processor/internal/application/ports/outbound/outboxsink"
+	"task-processor/internal/infrastructure/config"
+	sinks "task-processor/internal/infrastructure/adapters/outbound/outboxsink"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// newEventSink returns the sink lifecycle events are relayed to. The sink
+// settings are only read when the outbox is enabled, a disabled outbox has no sink.
+func newEventSink(cfg config.Outbox, client *redis.Client) (outboxsink.Sink, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	return sinks.New(cfg, client)
+}
\ No newline at end of file
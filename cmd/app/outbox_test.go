@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"task-processor/internal/infrastructure/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventSink_DisabledOutboxNeedsNoSink(t *testing.T) {
+	sink, err := newEventSink(config.Outbox{Enabled: false}, nil)
+
+	require.NoError(t, err)
+	assert.Nil(t, sink)
+}
+
+func TestNewEventSink_EnabledOutboxRequiresKnownSink(t *testing.T) {
+	_, err := newEventSink(config.Outbox{Enabled: true}, nil)
+
+	assert.ErrorContains(t, err, "unknown outbox sink")
+}
+
+func TestNewEventSink_EnabledOutbox(t *testing.T) {
+	sink, err := newEventSink(config.Outbox{Enabled: true, Sink: "stdout"}, nil)
+
+	require.NoError(t, err)
+	assert.NotNil(t, sink)
+}
\ No newline at end of file
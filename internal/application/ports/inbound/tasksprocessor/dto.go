@@ -1,5 +1,13 @@
 package tasksprocessor
 
+import (
+	"encoding/json"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type ProcessTasksRequest struct {
 	// Limit defines the maximum number of tasks to acquire and process.
 	Limit          int   
@@ -9,27 +17,170 @@ type ProcessTasksRequest struct {
 	// MaxDelayMS specifies the maximum delay in milliseconds to simulate
 	// processing time for each task. If set to 0, no delay is applied.
 	MaxDelayMS     int    
-	// SuccessRate determines the probability of successful task processing.
-	// Value must be between 0.0 (0% success) and 1.0 (100% success).
-	SuccessRate    float64 
+	// IncludeDetails adds the outcome of every acquired task to the response.
+	IncludeDetails bool
 }
 
 type ProcessTasksResponse struct {
 	// ProcessedCount indicates the total number of tasks that were 
 	// successfully acquired and attempted to be processed.
-	// This count includes both successful and failed processing attempts.
+	// This count includes successful, failed and errored processing attempts.
 	ProcessedCount int 
 	// SuccessCount shows how many tasks were successfully processed
 	// and marked with PROCESSED status in the database.
 	SuccessCount   int 
-	// FailedCount indicates how many tasks failed during processing
+	// FailedCount indicates how many tasks were failed by their handler
 	// and were marked with FAILED status in the database.
 	FailedCount    int 
+	// ErrorCount indicates how many tasks could not be processed because of
+	// an infrastructure error, e.g. the result could not be stored. They stay
+	// leased until the lease expires and are then picked up again.
+	ErrorCount     int
+	// ReleasedCount indicates how many acquired tasks were returned to the
+	// queue as NEW, without counting the attempt, because the request was
+	// cancelled before or while they were processed.
+	ReleasedCount  int
+	// Details lists the outcome of every acquired task in acquisition order.
+	// Only set when IncludeDetails was requested.
+	Details        []TaskOutcome
+}
+
+// TaskOutcomeKind classifies what became of a single acquired task
+type TaskOutcomeKind string
+
+const (
+	// OutcomeProcessed tasks were processed successfully and marked PROCESSED
+	OutcomeProcessed    TaskOutcomeKind = "processed"
+	// OutcomeFailed tasks were failed by their handler and will be retried
+	OutcomeFailed       TaskOutcomeKind = "failed"
+	// OutcomeDeadLettered tasks failed their last attempt and moved to the dead-letter queue
+	OutcomeDeadLettered TaskOutcomeKind = "dead_lettered"
+	// OutcomeReleased tasks were returned to the queue without counting the attempt
+	OutcomeReleased     TaskOutcomeKind = "released"
+	// OutcomeError tasks hit an infrastructure error rather than a handler failure
+	OutcomeError        TaskOutcomeKind = "error"
+)
+
+// TaskOutcome describes how processing a single task ended.
+type TaskOutcome struct {
+	TaskID   uuid.UUID
+	Outcome  TaskOutcomeKind
+	// Status is the status the task was left in
+	Status   domain.TaskStatus
+	// Attempt is the number of the attempt made by this request
+	Attempt  int
+	Duration time.Duration
+	// Error is the handler or infrastructure error, empty on success
+	Error    string
 }
 
 // BatchCreateTasksRequest defines the input for creating multiple tasks at once.
 type BatchCreateTasksRequest struct {
-	// Count specifies the number of tasks to create in a single batch.
-	// Must be greater than 0.
-	Count int
+	// Tasks lists the tasks to create in a single batch.
+	// Must contain at least one element.
+	Tasks []TaskInput
+}
+
+// TaskInput describes a single task to be enqueued.
+type TaskInput struct {
+	// Type identifies the kind of work, e.g. "email" or "report".
+	Type     string
+	// Payload is an arbitrary JSON document passed to the task handler.
+	// An empty payload is stored as an empty JSON object.
+	Payload  json.RawMessage
+	// Priority orders acquisition, higher values are served first.
+	// Waiting tasks age upwards so low priorities are not starved.
+	Priority int
+	// RunAt delays the task until the given time. Takes precedence over Delay.
+	RunAt    *time.Time
+	// Delay postpones the task by the given duration from creation.
+	Delay    time.Duration
+	// CallbackURL is notified when the task is processed or dead-lettered.
+	// Empty falls back to the URL configured for the task type, if any.
+	CallbackURL string
+}
+
+// RescheduleTaskRequest moves a pending task to a new due time.
+type RescheduleTaskRequest struct {
+	// RunAt is the new due time. Takes precedence over Delay.
+	RunAt *time.Time
+	// Delay sets the due time relative to now. Zero with a nil RunAt
+	// makes the task due immediately.
+	Delay time.Duration
+}
+
+// ScheduleInput defines a recurring schedule that enqueues tasks.
+type ScheduleInput struct {
+	// Name identifies the schedule and must be unique.
+	Name          string
+	// CronExpr is a 5-field cron expression or a descriptor such as @daily.
+	// Mutually exclusive with Interval.
+	CronExpr      string
+	// Interval fires the schedule at a fixed rate. Mutually exclusive with CronExpr.
+	Interval      time.Duration
+	// TaskType is the type of every enqueued task.
+	TaskType      string
+	// Payload is copied into every enqueued task. The {{scheduled_at}}
+	// placeholder is replaced with the tick time.
+	Payload       json.RawMessage
+	// Priority of the enqueued tasks.
+	Priority      int
+	// MisfirePolicy decides what happens to missed ticks, defaults to skip.
+	MisfirePolicy string
+	// Enabled schedules fire, disabled ones are kept but never fire.
+	Enabled       bool
+}
+
+// ListTasksRequest selects a page of tasks, newest first.
+type ListTasksRequest struct {
+	// Statuses keeps tasks in any of the given statuses. Empty means all.
+	Statuses      []domain.TaskStatus
+	// Type keeps tasks of the given type. Empty means all.
+	Type          string
+	// CreatedFrom keeps tasks created at or after the given time.
+	CreatedFrom   *time.Time
+	// CreatedTo keeps tasks created before the given time.
+	CreatedTo     *time.Time
+	// ErrorContains keeps tasks whose last error contains the text.
+	ErrorContains string
+	// Cursor is the NextCursor of the previous page, empty for the first page.
+	Cursor        string
+	// Limit is the maximum page size.
+	Limit         int
+}
+
+// TaskPage is a single page of a task listing.
+type TaskPage struct {
+	// Tasks on this page.
+	Tasks      []*domain.Task
+	// NextCursor fetches the following page, empty on the last page.
+	NextCursor string
+}
+
+// DeadLetterFilter selects tasks in the dead-letter queue. Zero values match everything.
+type DeadLetterFilter struct {
+	// Type keeps tasks of the given type.
+	Type          string
+	// CreatedFrom keeps tasks created at or after the given time.
+	CreatedFrom   *time.Time
+	// CreatedTo keeps tasks created before the given time.
+	CreatedTo     *time.Time
+	// ErrorContains keeps tasks whose last error contains the text.
+	ErrorContains string
+}
+
+// ListDeadLetterRequest selects a page of dead-lettered tasks, newest first.
+type ListDeadLetterRequest struct {
+	Filter DeadLetterFilter
+	// Cursor is the NextCursor of the previous page, empty for the first page.
+	Cursor string
+	// Limit is the maximum page size.
+	Limit  int
+}
+
+// DeadLetterBulkRequest applies an operation to dead-lettered tasks matching a filter.
+type DeadLetterBulkRequest struct {
+	Filter DeadLetterFilter
+	// Limit caps the number of tasks affected by a single request.
+	Limit  int
 }
\ No newline at end of file
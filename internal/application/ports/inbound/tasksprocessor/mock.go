@@ -0,0 +1,30 @@
+package tasksprocessor
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTasksProcessor struct {
+	mock.Mock
+}
+
+func (m *MockTasksProcessor) ProcessTasks(ctx context.Context, request *ProcessTasksRequest) (*ProcessTasksResponse, error) {
+	args := m.Called(ctx, request)
+	var resp *ProcessTasksResponse
+	if r := args.Get(0); r != nil {
+		resp = r.(*ProcessTasksResponse)
+	}
+	return resp, args.Error(1)
+}
+
+func (m *MockTasksProcessor) StartRun(ctx context.Context, request *ProcessTasksRequest) (*domain.ProcessingRun, error) {
+	args := m.Called(ctx, request)
+	var run *domain.ProcessingRun
+	if r := args.Get(0); r != nil {
+		run = r.(*domain.ProcessingRun)
+	}
+	return run, args.Error(1)
+}
\ No newline at end of file
@@ -2,11 +2,14 @@ package tasksprocessor
 
 import (
 	"context"
+	"task-processor/internal/domain"
 )
 
 // TasksProcessor defines the interface for processing tasks in the system.
 type TasksProcessor interface {
 	// ProcessTasks processes a batch of tasks according to the given request parameters.
 	ProcessTasks(ctx context.Context, request *ProcessTasksRequest) (*ProcessTasksResponse, error)
-}
-
+	// StartRun processes a batch of tasks in the background and returns the run
+	// tracking its progress. domain.ErrShuttingDown is returned when no more work is accepted.
+	StartRun(ctx context.Context, request *ProcessTasksRequest) (*domain.ProcessingRun, error)
+}
\ No newline at end of file
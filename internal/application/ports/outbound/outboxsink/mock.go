@@ -0,0 +1,17 @@
+package outboxsink
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSink struct {
+	mock.Mock
+}
+
+func (m *MockSink) Publish(ctx context.Context, message *domain.OutboxMessage) error {
+	args := m.Called(ctx, message)
+	return args.Error(0)
+}
\ No newline at end of file
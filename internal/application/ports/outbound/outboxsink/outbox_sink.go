@@ -0,0 +1,13 @@
+package outboxsink
+
+import (
+	"context"
+	"task-processor/internal/domain"
+)
+
+// Sink publishes outbox messages to other systems. Delivery is at least once:
+// a message may be published again if the relay stops before recording it,
+// so consumers should deduplicate on the message ID.
+type Sink interface {
+	Publish(ctx context.Context, message *domain.OutboxMessage) error
+}
\ No newline at end of file
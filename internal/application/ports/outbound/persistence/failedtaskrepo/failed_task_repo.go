@@ -3,9 +3,30 @@ package failedtaskrepo
 import (
 	"context"
 	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
 )
 
 // FailedTaskRepository defines the interface for failed task data access operations
 type FailedTaskRepository interface {
+    // Create stores a task moved to the dead-letter queue and records
+    // a domain.EventDeadLettered event atomically with it
     Create(ctx context.Context, task *domain.Task) error
+
+    // Get returns a dead-lettered task by ID or domain.ErrTaskNotFound
+    Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+
+    // List returns up to filter.Limit dead-lettered tasks matching filter,
+    // ordered by (created_at, id) descending
+    List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error)
+
+    // Remove deletes a dead-lettered task and returns it, or domain.ErrTaskNotFound
+    Remove(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+
+    // RemoveMatching deletes up to filter.Limit dead-lettered tasks matching
+    // filter and returns them
+    RemoveMatching(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error)
+
+    // Count returns the number of dead-lettered tasks
+    Count(ctx context.Context) (int, error)
 }
\ No newline at end of file
@@ -4,6 +4,7 @@ import (
 	"context"
 	"task-processor/internal/domain"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -14,4 +15,37 @@ type MockFailedTaskRepo struct {
 func (m *MockFailedTaskRepo) Create(ctx context.Context, task *domain.Task) error {
 	args := m.Called(ctx, task)
 	return args.Error(0)
+}
+
+func (m *MockFailedTaskRepo) Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	args := m.Called(ctx, taskID)
+	var task *domain.Task
+	if t := args.Get(0); t != nil {
+		task = t.(*domain.Task)
+	}
+	return task, args.Error(1)
+}
+
+func (m *MockFailedTaskRepo) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*domain.Task), args.Error(1)
+}
+
+func (m *MockFailedTaskRepo) Remove(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	args := m.Called(ctx, taskID)
+	var task *domain.Task
+	if t := args.Get(0); t != nil {
+		task = t.(*domain.Task)
+	}
+	return task, args.Error(1)
+}
+
+func (m *MockFailedTaskRepo) RemoveMatching(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*domain.Task), args.Error(1)
+}
+
+func (m *MockFailedTaskRepo) Count(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
 }
\ No newline at end of file
@@ -0,0 +1,11 @@
+package locker
+
+import "context"
+
+// Locker provides cluster-wide mutual exclusion between instances
+type Locker interface {
+	// TryLock takes the lock identified by key for the duration of the current
+	// transaction and reports whether it was acquired. It must be called inside
+	// TxManager.WithTransaction; the lock is released on commit or rollback.
+	TryLock(ctx context.Context, key int64) (bool, error)
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+package locker
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLocker struct {
+	mock.Mock
+}
+
+func (m *MockLocker) TryLock(ctx context.Context, key int64) (bool, error) {
+	args := m.Called(ctx, key)
+	return args.Bool(0), args.Error(1)
+}
\ No newline at end of file
@@ -0,0 +1,38 @@
+package outboxrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+func (m *MockOutboxRepository) Append(ctx context.Context, messages []*domain.OutboxMessage) error {
+	args := m.Called(ctx, messages)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]*domain.OutboxMessage, error) {
+	args := m.Called(ctx, limit, lease)
+	return args.Get(0).([]*domain.OutboxMessage), args.Error(1)
+}
+
+func (m *MockOutboxRepository) MarkPublished(ctx context.Context, ids []int64) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) Release(ctx context.Context, ids []int64) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+func (m *MockOutboxRepository) DeletePublishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	args := m.Called(ctx, cutoff, limit)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
@@ -0,0 +1,28 @@
+package outboxrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// OutboxRepository stores lifecycle messages until they are published.
+// Messages are appended in the transaction of the change they describe.
+type OutboxRepository interface {
+
+	// Append stores the messages
+	Append(ctx context.Context, messages []*domain.OutboxMessage) error
+
+	// Claim reserves up to limit unpublished messages for lease, oldest first.
+	// Nothing is claimed while an earlier claim is still held, so messages go out in order.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*domain.OutboxMessage, error)
+
+	// MarkPublished records that the messages were published
+	MarkPublished(ctx context.Context, ids []int64) error
+
+	// Release gives up the claim on messages that were not published
+	Release(ctx context.Context, ids []int64) error
+
+	// DeletePublishedBefore removes up to limit messages published before cutoff
+	DeletePublishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error)
+}
\ No newline at end of file
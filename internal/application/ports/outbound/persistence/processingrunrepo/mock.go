@@ -0,0 +1,48 @@
+package processingrunrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProcessingRunRepository struct {
+	mock.Mock
+}
+
+func (m *MockProcessingRunRepository) Create(ctx context.Context, run *domain.ProcessingRun) error {
+	args := m.Called(ctx, run)
+	return args.Error(0)
+}
+
+func (m *MockProcessingRunRepository) Get(ctx context.Context, runID uuid.UUID) (*domain.ProcessingRun, error) {
+	args := m.Called(ctx, runID)
+	var run *domain.ProcessingRun
+	if r := args.Get(0); r != nil {
+		run = r.(*domain.ProcessingRun)
+	}
+	return run, args.Error(1)
+}
+
+func (m *MockProcessingRunRepository) AddProgress(ctx context.Context, runID uuid.UUID, delta domain.RunCounters) error {
+	args := m.Called(ctx, runID, delta)
+	return args.Error(0)
+}
+
+func (m *MockProcessingRunRepository) Finish(ctx context.Context, runID uuid.UUID, status domain.RunStatus, errorMessage string) error {
+	args := m.Called(ctx, runID, status, errorMessage)
+	return args.Error(0)
+}
+
+func (m *MockProcessingRunRepository) FailStale(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	args := m.Called(ctx, staleAfter, limit)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockProcessingRunRepository) DeleteFinishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	args := m.Called(ctx, cutoff, limit)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
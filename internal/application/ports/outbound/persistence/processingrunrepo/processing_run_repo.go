@@ -0,0 +1,32 @@
+package processingrunrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProcessingRunRepository stores background processing runs and their progress
+type ProcessingRunRepository interface {
+
+	// Create inserts a new run
+	Create(ctx context.Context, run *domain.ProcessingRun) error
+
+	// Get returns the run, or domain.ErrRunNotFound
+	Get(ctx context.Context, runID uuid.UUID) (*domain.ProcessingRun, error)
+
+	// AddProgress adds the counters to those of the run
+	AddProgress(ctx context.Context, runID uuid.UUID, delta domain.RunCounters) error
+
+	// Finish sets the final status of a running run. domain.ErrRunFinished is
+	// returned when the run already has one.
+	Finish(ctx context.Context, runID uuid.UUID, status domain.RunStatus, errorMessage string) error
+
+	// FailStale marks running runs without progress for staleAfter as failed and returns how many
+	FailStale(ctx context.Context, staleAfter time.Duration, limit int) (int, error)
+
+	// DeleteFinishedBefore removes up to limit runs finished before cutoff and returns how many
+	DeleteFinishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error)
+}
\ No newline at end of file
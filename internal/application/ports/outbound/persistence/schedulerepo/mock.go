@@ -0,0 +1,53 @@
+package schedulerepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockScheduleRepository struct {
+	mock.Mock
+}
+
+func (m *MockScheduleRepository) Create(ctx context.Context, schedule *domain.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepository) Get(ctx context.Context, id uuid.UUID) (*domain.Schedule, error) {
+	args := m.Called(ctx, id)
+	var schedule *domain.Schedule
+	if s := args.Get(0); s != nil {
+		schedule = s.(*domain.Schedule)
+	}
+	return schedule, args.Error(1)
+}
+
+func (m *MockScheduleRepository) List(ctx context.Context) ([]*domain.Schedule, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*domain.Schedule), args.Error(1)
+}
+
+func (m *MockScheduleRepository) Update(ctx context.Context, schedule *domain.Schedule) error {
+	args := m.Called(ctx, schedule)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockScheduleRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*domain.Schedule), args.Error(1)
+}
+
+func (m *MockScheduleRepository) MarkFired(ctx context.Context, id uuid.UUID, lastRunAt, nextRunAt time.Time) error {
+	args := m.Called(ctx, id, lastRunAt, nextRunAt)
+	return args.Error(0)
+}
\ No newline at end of file
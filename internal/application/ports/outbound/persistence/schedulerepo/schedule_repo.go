@@ -0,0 +1,34 @@
+package schedulerepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScheduleRepository defines the interface for recurring schedule data access operations
+type ScheduleRepository interface {
+	// Create stores a new schedule and fills its ID and timestamps.
+	// Returns domain.ErrScheduleNameTaken when the name is already used
+	Create(ctx context.Context, schedule *domain.Schedule) error
+
+	// Get returns a schedule by ID or domain.ErrScheduleNotFound
+	Get(ctx context.Context, id uuid.UUID) (*domain.Schedule, error)
+
+	// List returns all schedules ordered by name
+	List(ctx context.Context) ([]*domain.Schedule, error)
+
+	// Update replaces the definition of an existing schedule
+	Update(ctx context.Context, schedule *domain.Schedule) error
+
+	// Delete removes a schedule
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListDue returns up to limit enabled schedules with next_run_at <= now
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error)
+
+	// MarkFired records the last fired tick and the next one
+	MarkFired(ctx context.Context, id uuid.UUID, lastRunAt, nextRunAt time.Time) error
+}
\ No newline at end of file
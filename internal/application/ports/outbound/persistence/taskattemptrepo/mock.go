@@ -0,0 +1,23 @@
+package taskattemptrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTaskAttemptRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaskAttemptRepository) Create(ctx context.Context, attempt *domain.TaskAttempt) error {
+	args := m.Called(ctx, attempt)
+	return args.Error(0)
+}
+
+func (m *MockTaskAttemptRepository) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskAttempt, error) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]*domain.TaskAttempt), args.Error(1)
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+package taskattemptrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// TaskAttemptRepository stores the per-attempt history of tasks
+type TaskAttemptRepository interface {
+
+	// Create records a finished attempt
+	Create(ctx context.Context, attempt *domain.TaskAttempt) error
+
+	// ListByTask returns the attempts of a task, oldest first
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskAttempt, error)
+}
\ No newline at end of file
@@ -0,0 +1,24 @@
+package taskeventrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTaskEventRepository struct {
+	mock.Mock
+}
+
+func (m *MockTaskEventRepository) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskEvent, error) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]*domain.TaskEvent), args.Error(1)
+}
+
+func (m *MockTaskEventRepository) DeleteOlderThan(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	args := m.Called(ctx, cutoff, limit)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
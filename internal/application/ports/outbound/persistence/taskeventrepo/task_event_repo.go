@@ -0,0 +1,21 @@
+package taskeventrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskEventRepository reads and prunes the task audit log.
+// Events are written by the task repositories along with each transition.
+type TaskEventRepository interface {
+
+	// ListByTask returns the events of a task, oldest first
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskEvent, error)
+
+	// DeleteOlderThan removes up to limit events created before cutoff
+	// and returns how many were removed
+	DeleteOlderThan(ctx context.Context, cutoff time.Time, limit int) (int, error)
+}
\ No newline at end of file
@@ -2,7 +2,9 @@ package taskrepo
 
 import (
 	"context"
+	"encoding/json"
 	"task-processor/internal/domain"
+	"time"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -16,22 +18,75 @@ func (m *MockTaskRepository) BatchCreate(ctx context.Context, tasks []*domain.Ta
 	return args.Get(0).([]uuid.UUID), args.Error(1)
 }
 
-func (m *MockTaskRepository) AcquireTasks(ctx context.Context, limit int) ([]*domain.Task, error) {
-	args := m.Called(ctx, limit)
+func (m *MockTaskRepository) AcquireTasks(ctx context.Context, limit int, lease domain.Lease) ([]*domain.Task, error) {
+	args := m.Called(ctx, limit, lease)
 	return args.Get(0).([]*domain.Task), args.Error(1)
 }
 
-func (m *MockTaskRepository) MarkAsProcessed(ctx context.Context, taskID uuid.UUID) error {
-	args := m.Called(ctx, taskID)
+func (m *MockTaskRepository) ExtendLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	args := m.Called(ctx, taskID, lease)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) ReleaseLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	args := m.Called(ctx, taskID, lease)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) ReleaseExpiredLeases(ctx context.Context, limit int, retryAfter func(attempts int) time.Duration) (int, error) {
+	args := m.Called(ctx, limit, retryAfter)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTaskRepository) MarkAsProcessed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, result json.RawMessage) error {
+	args := m.Called(ctx, taskID, lease, result)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) MarkAsFailed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, errorMsg string, retryAfter time.Duration) error {
+	args := m.Called(ctx, taskID, lease, errorMsg, retryAfter)
+	return args.Error(0)
+}
+
+func (m *MockTaskRepository) Delete(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	args := m.Called(ctx, taskID, lease)
 	return args.Error(0)
 }
 
-func (m *MockTaskRepository) MarkAsFailed(ctx context.Context, taskID uuid.UUID, errorMsg string) error {
-	args := m.Called(ctx, taskID, errorMsg)
+func (m *MockTaskRepository) DeleteExhausted(ctx context.Context, limit int) ([]*domain.Task, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).([]*domain.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Restore(ctx context.Context, tasks []*domain.Task) error {
+	args := m.Called(ctx, tasks)
 	return args.Error(0)
 }
 
-func (m *MockTaskRepository) Delete(ctx context.Context, taskID uuid.UUID) error {
+func (m *MockTaskRepository) Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
 	args := m.Called(ctx, taskID)
+	var task *domain.Task
+	if t := args.Get(0); t != nil {
+		task = t.(*domain.Task)
+	}
+	return task, args.Error(1)
+}
+
+func (m *MockTaskRepository) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*domain.Task), args.Error(1)
+}
+
+func (m *MockTaskRepository) Reschedule(ctx context.Context, taskID uuid.UUID, runAt time.Time) error {
+	args := m.Called(ctx, taskID, runAt)
 	return args.Error(0)
+}
+
+func (m *MockTaskRepository) Stats(ctx context.Context) (*domain.QueueStats, error) {
+	args := m.Called(ctx)
+	var stats *domain.QueueStats
+	if s := args.Get(0); s != nil {
+		stats = s.(*domain.QueueStats)
+	}
+	return stats, args.Error(1)
 }
\ No newline at end of file
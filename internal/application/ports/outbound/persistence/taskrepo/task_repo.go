@@ -2,27 +2,72 @@ package taskrepo
 
 import (
 	"context"
+	"encoding/json"
 	"task-processor/internal/domain"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 
-// TaskRepository defines the interface for task data access operations
+// TaskRepository defines the interface for task data access operations.
+// Methods changing the state of a task record a domain.TaskEvent atomically with the change.
 type TaskRepository interface {
 
 	// BatchCreate creates multiple tasks in a single operation
 	BatchCreate(ctx context.Context, tasks []*domain.Task) ([]uuid.UUID, error)
 	
 	// AcquireTasks acquires tasks for processing with pessimistic locking
-	AcquireTasks(ctx context.Context, limit int) ([]*domain.Task, error)
+	// and leases them to lease.Owner for lease.Duration
+	AcquireTasks(ctx context.Context, limit int, lease domain.Lease) ([]*domain.Task, error)
+
+	// ExtendLease prolongs the lease held by lease.Owner on a PROCESSING task.
+	// Returns domain.ErrLeaseLost if the caller no longer holds the lease.
+	ExtendLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error
+
+	// ReleaseExpiredLeases returns up to limit PROCESSING tasks whose lease
+	// has expired back to the queue, keeping the attempt they consumed.
+	// retryAfter gives the delay before the next attempt from the attempts made.
+	ReleaseExpiredLeases(ctx context.Context, limit int, retryAfter func(attempts int) time.Duration) (int, error)
+	
+	// ReleaseLease returns a PROCESSING task leased by lease.Owner to NEW and gives
+	// back the attempt it consumed. Returns domain.ErrLeaseLost if the caller
+	// no longer holds the lease.
+	ReleaseLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error
 	
-	// MarkAsProcessed marks task as processed
-	MarkAsProcessed(ctx context.Context, taskID uuid.UUID) error
+	// MarkAsProcessed marks a task leased by lease.Owner as processed and stores
+	// the handler result. Returns domain.ErrLeaseLost if the caller no longer holds the lease.
+	MarkAsProcessed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, result json.RawMessage) error
 	
-	// MarkAsFailed marks task as failed and records error message
-	MarkAsFailed(ctx context.Context, taskID uuid.UUID, errorMsg string) error
+	// MarkAsFailed marks a task leased by lease.Owner as failed, records error message
+	// and postpones the next attempt by retryAfter. Returns domain.ErrLeaseLost if the
+	// caller no longer holds the lease.
+	MarkAsFailed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, errorMsg string, retryAfter time.Duration) error
+
+	// Reschedule moves a pending task to runAt. Returns domain.ErrTaskNotFound
+	// or domain.ErrTaskNotPending when the task cannot be rescheduled
+	Reschedule(ctx context.Context, taskID uuid.UUID, runAt time.Time) error
+
+	// Restore inserts previously removed tasks back into the queue under their
+	// original IDs, as NEW tasks with no attempts made
+	Restore(ctx context.Context, tasks []*domain.Task) error
+
+	// Get returns a task by ID or domain.ErrTaskNotFound
+	Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+
+	// List returns up to filter.Limit tasks matching filter,
+	// ordered by (created_at, id) descending
+	List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error)
+
+	// Delete removes a task leased by lease.Owner from the queue.
+	// Returns domain.ErrLeaseLost if the caller no longer holds the lease.
+	Delete(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error
+
+	// DeleteExhausted removes up to limit FAILED tasks without attempts left
+	// and returns the removed tasks
+	DeleteExhausted(ctx context.Context, limit int) ([]*domain.Task, error)
 
-	// Delete removes row from table
-	Delete(ctx context.Context, taskID uuid.UUID) error
+	// Stats returns per-status counts, the number of exhausted tasks and
+	// the creation time of the oldest NEW task
+	Stats(ctx context.Context) (*domain.QueueStats, error)
 }
\ No newline at end of file
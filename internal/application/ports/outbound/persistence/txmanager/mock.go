@@ -12,4 +12,9 @@ type MockTxManager struct{
 func (m *MockTxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
 	m.Called(ctx, fn)
 	return fn(ctx)
+}
+
+func (m *MockTxManager) WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.Called(ctx, fn)
+	return fn(ctx)
 }
\ No newline at end of file
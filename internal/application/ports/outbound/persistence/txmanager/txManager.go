@@ -4,4 +4,7 @@ import "context"
 
 type TxManager interface { 
 	WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithSavepoint runs fn inside the current transaction, undoing only its
+	// writes when it fails so the transaction stays usable
+	WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error
 }
\ No newline at end of file
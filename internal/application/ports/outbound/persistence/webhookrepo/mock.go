@@ -0,0 +1,38 @@
+package webhookrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockWebhookDeliveryRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookDeliveryRepository) Create(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	args := m.Called(ctx, delivery)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	args := m.Called(ctx, limit, lease)
+	return args.Get(0).([]*domain.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockWebhookDeliveryRepository) MarkDelivered(ctx context.Context, id int64, statusCode int) error {
+	args := m.Called(ctx, id, statusCode)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) MarkFailed(ctx context.Context, id int64, statusCode int, message string, retryAfter time.Duration) error {
+	args := m.Called(ctx, id, statusCode, message, retryAfter)
+	return args.Error(0)
+}
+
+func (m *MockWebhookDeliveryRepository) MarkAbandoned(ctx context.Context, id int64, statusCode int, message string) error {
+	args := m.Called(ctx, id, statusCode, message)
+	return args.Error(0)
+}
\ No newline at end of file
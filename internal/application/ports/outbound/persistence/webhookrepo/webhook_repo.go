@@ -0,0 +1,28 @@
+package webhookrepo
+
+import (
+	"context"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// WebhookDeliveryRepository stores completion notifications until they are delivered
+type WebhookDeliveryRepository interface {
+
+	// Create queues a delivery, due immediately
+	Create(ctx context.Context, delivery *domain.WebhookDelivery) error
+
+	// ClaimDue takes up to limit pending deliveries whose next attempt is due and
+	// counts the attempt. Claimed deliveries are not due again before lease passes,
+	// so an instance dying mid-delivery only delays them.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error)
+
+	// MarkDelivered records the receiver's acknowledgement
+	MarkDelivered(ctx context.Context, id int64, statusCode int) error
+
+	// MarkFailed records a failed attempt and makes the delivery due after retryAfter
+	MarkFailed(ctx context.Context, id int64, statusCode int, message string, retryAfter time.Duration) error
+
+	// MarkAbandoned records the last failed attempt of a delivery that will not be retried
+	MarkAbandoned(ctx context.Context, id int64, statusCode int, message string) error
+}
\ No newline at end of file
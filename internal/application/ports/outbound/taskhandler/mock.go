@@ -0,0 +1,29 @@
+package taskhandler
+
+import (
+	"context"
+	"encoding/json"
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTaskHandler struct {
+	mock.Mock
+}
+
+func (m *MockTaskHandler) Handle(ctx context.Context, task *domain.Task) (json.RawMessage, error) {
+	args := m.Called(ctx, task)
+	result, _ := args.Get(0).(json.RawMessage)
+	return result, args.Error(1)
+}
+
+type MockRegistry struct {
+	mock.Mock
+}
+
+func (m *MockRegistry) Get(taskType string) (TaskHandler, bool) {
+	args := m.Called(taskType)
+	handler, _ := args.Get(0).(TaskHandler)
+	return handler, args.Bool(1)
+}
\ No newline at end of file
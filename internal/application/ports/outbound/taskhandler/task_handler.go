@@ -0,0 +1,20 @@
+package taskhandler
+
+import (
+	"context"
+	"encoding/json"
+	"task-processor/internal/domain"
+)
+
+// TaskHandler executes the business logic behind a single task type
+type TaskHandler interface {
+	// Handle processes the task and returns an optional JSON result.
+	// A non-nil error marks the attempt as failed.
+	Handle(ctx context.Context, task *domain.Task) (json.RawMessage, error)
+}
+
+// Registry resolves task handlers by task type
+type Registry interface {
+	// Get returns the handler registered for the task type
+	Get(taskType string) (TaskHandler, bool)
+}
\ No newline at end of file
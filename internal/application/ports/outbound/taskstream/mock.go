@@ -0,0 +1,15 @@
+package taskstream
+
+import (
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) Publish(event *domain.StreamEvent) {
+	m.Called(event)
+}
\ No newline at end of file
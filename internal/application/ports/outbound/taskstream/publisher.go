@@ -0,0 +1,9 @@
+package taskstream
+
+import "task-processor/internal/domain"
+
+// Publisher pushes task lifecycle events to live subscribers. Publishing must
+// not block processing: events a subscriber cannot take in time are dropped.
+type Publisher interface {
+	Publish(event *domain.StreamEvent)
+}
\ No newline at end of file
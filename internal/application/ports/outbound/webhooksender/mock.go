@@ -0,0 +1,17 @@
+package webhooksender
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSender struct {
+	mock.Mock
+}
+
+func (m *MockSender) Send(ctx context.Context, delivery *domain.WebhookDelivery) (int, error) {
+	args := m.Called(ctx, delivery)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+package webhooksender
+
+import (
+	"context"
+	"task-processor/internal/domain"
+)
+
+// Sender posts completion notifications to their receivers
+type Sender interface {
+	// Send delivers the payload and returns the HTTP status code of the response.
+	// An error means no response was received.
+	Send(ctx context.Context, delivery *domain.WebhookDelivery) (int, error)
+}
\ No newline at end of file
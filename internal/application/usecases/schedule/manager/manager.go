@@ -0,0 +1,126 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/application/usecases/schedule/spec"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var emptyPayload = json.RawMessage(`{}`)
+
+// Manager creates, reads, updates and deletes recurring schedules
+type Manager struct {
+	scheduleRepo schedulerepo.ScheduleRepository
+	now          func() time.Time
+}
+
+func NewManager(scheduleRepo schedulerepo.ScheduleRepository) *Manager {
+	return &Manager{
+		scheduleRepo: scheduleRepo,
+		now:          time.Now,
+	}
+}
+
+// Create validates the input and stores a schedule due at its first tick from now
+func (m *Manager) Create(ctx context.Context, input *tasksprocessor.ScheduleInput) (*domain.Schedule, error) {
+	schedule := &domain.Schedule{}
+	if err := m.apply(schedule, input); err != nil {
+		return nil, err
+	}
+
+	if err := m.scheduleRepo.Create(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// Get returns a schedule by ID
+func (m *Manager) Get(ctx context.Context, id uuid.UUID) (*domain.Schedule, error) {
+	schedule, err := m.scheduleRepo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// List returns all schedules
+func (m *Manager) List(ctx context.Context) ([]*domain.Schedule, error) {
+	schedules, err := m.scheduleRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	return schedules, nil
+}
+
+// Update replaces the definition of a schedule. The next run is recomputed
+// from now, ticks missed before the update are dropped.
+func (m *Manager) Update(ctx context.Context, id uuid.UUID, input *tasksprocessor.ScheduleInput) (*domain.Schedule, error) {
+	schedule, err := m.scheduleRepo.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+
+	if err := m.apply(schedule, input); err != nil {
+		return nil, err
+	}
+
+	if err := m.scheduleRepo.Update(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// Delete removes a schedule, tasks it already enqueued are kept
+func (m *Manager) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := m.scheduleRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+	return nil
+}
+
+// apply validates input and copies it into schedule
+func (m *Manager) apply(schedule *domain.Schedule, input *tasksprocessor.ScheduleInput) error {
+	s, err := spec.Parse(input.CronExpr, input.Interval)
+	if err != nil {
+		return err
+	}
+
+	if input.Name == "" {
+		return fmt.Errorf("%w: name is required", domain.ErrInvalidSchedule)
+	}
+	if input.TaskType == "" {
+		return fmt.Errorf("%w: task type is required", domain.ErrInvalidSchedule)
+	}
+
+	policy := domain.MisfirePolicy(input.MisfirePolicy)
+	switch policy {
+	case "":
+		policy = domain.MisfireSkip
+	case domain.MisfireSkip, domain.MisfireCatchUp:
+	default:
+		return fmt.Errorf("%w: unknown misfire policy %q", domain.ErrInvalidSchedule, input.MisfirePolicy)
+	}
+
+	payload := input.Payload
+	if len(payload) == 0 {
+		payload = emptyPayload
+	}
+
+	schedule.Name = input.Name
+	schedule.CronExpr = input.CronExpr
+	schedule.Interval = input.Interval
+	schedule.TaskType = input.TaskType
+	schedule.Payload = payload
+	schedule.Priority = input.Priority
+	schedule.MisfirePolicy = policy
+	schedule.Enabled = input.Enabled
+	schedule.NextRunAt = s.Next(m.now())
+	return nil
+}
\ No newline at end of file
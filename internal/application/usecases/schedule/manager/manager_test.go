@@ -0,0 +1,152 @@
+package manager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedNow = time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC)
+
+func newTestManager(repo *schedulerepo.MockScheduleRepository) *Manager {
+	m := NewManager(repo)
+	m.now = func() time.Time { return fixedNow }
+	return m
+}
+
+func TestCreate_Cron(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(schedulerepo.MockScheduleRepository)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Schedule")).Return(nil)
+
+	schedule, err := newTestManager(mockRepo).Create(ctx, &tasksprocessor.ScheduleInput{
+		Name:     "nightly-report",
+		CronExpr: "0 3 * * *",
+		TaskType: "report",
+		Enabled:  true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 0, 0, 0, time.UTC), schedule.NextRunAt)
+	assert.Equal(t, domain.MisfireSkip, schedule.MisfirePolicy)
+	assert.JSONEq(t, `{}`, string(schedule.Payload))
+	assert.True(t, schedule.Enabled)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreate_Interval(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(schedulerepo.MockScheduleRepository)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.Schedule")).Return(nil)
+
+	schedule, err := newTestManager(mockRepo).Create(ctx, &tasksprocessor.ScheduleInput{
+		Name:          "heartbeat",
+		Interval:      5 * time.Minute,
+		TaskType:      "ping",
+		Payload:       json.RawMessage(`{"at":"{{scheduled_at}}"}`),
+		MisfirePolicy: string(domain.MisfireCatchUp),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, fixedNow.Add(5*time.Minute), schedule.NextRunAt)
+	assert.Equal(t, domain.MisfireCatchUp, schedule.MisfirePolicy)
+}
+
+func TestCreate_Invalid(t *testing.T) {
+	cases := map[string]*tasksprocessor.ScheduleInput{
+		"no spec":        {Name: "a", TaskType: "t"},
+		"no name":        {CronExpr: "@hourly", TaskType: "t"},
+		"no type":        {Name: "a", CronExpr: "@hourly"},
+		"unknown policy": {Name: "a", CronExpr: "@hourly", TaskType: "t", MisfirePolicy: "later"},
+	}
+
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := new(schedulerepo.MockScheduleRepository)
+
+			_, err := newTestManager(mockRepo).Create(context.Background(), input)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidSchedule)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreate_NameTaken(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(schedulerepo.MockScheduleRepository)
+	mockRepo.On("Create", ctx, mock.Anything).Return(domain.ErrScheduleNameTaken)
+
+	_, err := newTestManager(mockRepo).Create(ctx, &tasksprocessor.ScheduleInput{
+		Name: "dup", CronExpr: "@hourly", TaskType: "t",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrScheduleNameTaken)
+}
+
+func TestUpdate_RecomputesNextRun(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	lastRun := fixedNow.Add(-time.Hour)
+	existing := &domain.Schedule{
+		ID:        id,
+		Name:      "old",
+		CronExpr:  "@daily",
+		TaskType:  "t",
+		NextRunAt: fixedNow.Add(-time.Minute),
+		LastRunAt: &lastRun,
+	}
+
+	mockRepo := new(schedulerepo.MockScheduleRepository)
+	mockRepo.On("Get", ctx, id).Return(existing, nil)
+	mockRepo.On("Update", ctx, existing).Return(nil)
+
+	schedule, err := newTestManager(mockRepo).Update(ctx, id, &tasksprocessor.ScheduleInput{
+		Name:     "new",
+		Interval: time.Minute,
+		TaskType: "t",
+		Enabled:  true,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "new", schedule.Name)
+	assert.Empty(t, schedule.CronExpr)
+	assert.Equal(t, fixedNow.Add(time.Minute), schedule.NextRunAt)
+	assert.Equal(t, &lastRun, schedule.LastRunAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdate_NotFound(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	mockRepo := new(schedulerepo.MockScheduleRepository)
+	mockRepo.On("Get", ctx, id).Return(nil, domain.ErrScheduleNotFound)
+
+	_, err := newTestManager(mockRepo).Update(ctx, id, &tasksprocessor.ScheduleInput{
+		Name: "a", CronExpr: "@hourly", TaskType: "t",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrScheduleNotFound)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestDelete_Error(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	mockRepo := new(schedulerepo.MockScheduleRepository)
+	mockRepo.On("Delete", ctx, id).Return(errors.New("db error"))
+
+	err := newTestManager(mockRepo).Delete(ctx, id)
+
+	assert.Error(t, err)
+}
\ No newline at end of file
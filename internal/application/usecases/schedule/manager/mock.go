@@ -0,0 +1,55 @@
+package manager
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockManager struct {
+	mock.Mock
+}
+
+func (m *MockManager) Create(ctx context.Context, input *tasksprocessor.ScheduleInput) (*domain.Schedule, error) {
+	args := m.Called(ctx, input)
+	var schedule *domain.Schedule
+	if s := args.Get(0); s != nil {
+		schedule = s.(*domain.Schedule)
+	}
+	return schedule, args.Error(1)
+}
+
+func (m *MockManager) Get(ctx context.Context, id uuid.UUID) (*domain.Schedule, error) {
+	args := m.Called(ctx, id)
+	var schedule *domain.Schedule
+	if s := args.Get(0); s != nil {
+		schedule = s.(*domain.Schedule)
+	}
+	return schedule, args.Error(1)
+}
+
+func (m *MockManager) List(ctx context.Context) ([]*domain.Schedule, error) {
+	args := m.Called(ctx)
+	var schedules []*domain.Schedule
+	if s := args.Get(0); s != nil {
+		schedules = s.([]*domain.Schedule)
+	}
+	return schedules, args.Error(1)
+}
+
+func (m *MockManager) Update(ctx context.Context, id uuid.UUID, input *tasksprocessor.ScheduleInput) (*domain.Schedule, error) {
+	args := m.Called(ctx, id, input)
+	var schedule *domain.Schedule
+	if s := args.Get(0); s != nil {
+		schedule = s.(*domain.Schedule)
+	}
+	return schedule, args.Error(1)
+}
+
+func (m *MockManager) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
\ No newline at end of file
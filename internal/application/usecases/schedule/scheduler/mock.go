@@ -0,0 +1,16 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockScheduler struct {
+	mock.Mock
+}
+
+func (m *MockScheduler) RunDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
@@ -0,0 +1,156 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/usecases/schedule/spec"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LockKey identifies the cluster-wide lock held while firing schedules
+const LockKey int64 = 0x7363686564756c65
+
+// TaskCreator enqueues the tasks of a fired schedule
+type TaskCreator interface {
+	CreateTasksBatch(ctx context.Context, request *tasksprocessor.BatchCreateTasksRequest) ([]uuid.UUID, error)
+}
+
+// Scheduler enqueues tasks for due schedules. Only the instance holding
+// the lock fires on a given tick, others skip it.
+type Scheduler struct {
+	scheduleRepo schedulerepo.ScheduleRepository
+	taskCreator  TaskCreator
+	txManager    txmanager.TxManager
+	locker       locker.Locker
+	batchSize    int
+	// maxCatchUp bounds the ticks fired at once by catch_up schedules
+	maxCatchUp   int
+	now          func() time.Time
+}
+
+func NewScheduler(
+	scheduleRepo schedulerepo.ScheduleRepository,
+	taskCreator  TaskCreator,
+	txManager    txmanager.TxManager,
+	locker       locker.Locker,
+	batchSize    int,
+	maxCatchUp   int,
+) *Scheduler {
+	return &Scheduler{
+		scheduleRepo: scheduleRepo,
+		taskCreator:  taskCreator,
+		txManager:    txManager,
+		locker:       locker,
+		batchSize:    batchSize,
+		maxCatchUp:   maxCatchUp,
+		now:          time.Now,
+	}
+}
+
+// RunDue fires up to one batch of due schedules and reports how many tasks were enqueued.
+// Tasks and the new schedule state are committed together, so a tick is never fired twice.
+// A schedule failing to fire is rolled back alone and reported, the others still fire.
+func (s *Scheduler) RunDue(ctx context.Context) (int, error) {
+	var (
+		enqueued int
+		failures []error
+	)
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		acquired, err := s.locker.TryLock(ctx, LockKey)
+		if err != nil {
+			return fmt.Errorf("failed to take scheduler lock: %w", err)
+		}
+		if !acquired {
+			// Another instance is firing schedules right now
+			return nil
+		}
+
+		now := s.now()
+		schedules, err := s.scheduleRepo.ListDue(ctx, now, s.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list due schedules: %w", err)
+		}
+
+		for _, schedule := range schedules {
+			var fired int
+			err := s.txManager.WithSavepoint(ctx, func(ctx context.Context) error {
+				var err error
+				fired, err = s.fire(ctx, schedule, now)
+				return err
+			})
+			if err != nil {
+				failures = append(failures, fmt.Errorf("schedule %s: %w", schedule.Name, err))
+				continue
+			}
+			enqueued += fired
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return enqueued, errors.Join(failures...)
+}
+
+// fire enqueues the tasks of the ticks due at now and moves the schedule to its next tick
+func (s *Scheduler) fire(ctx context.Context, schedule *domain.Schedule, now time.Time) (int, error) {
+	sp, err := spec.ForSchedule(schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	ticks := s.dueTicks(sp, schedule, now)
+	if len(ticks) == 0 {
+		return 0, nil
+	}
+
+	inputs := make([]tasksprocessor.TaskInput, len(ticks))
+	for i, tick := range ticks {
+		inputs[i] = tasksprocessor.TaskInput{
+			Type:     schedule.TaskType,
+			Payload:  renderPayload(schedule.Payload, tick),
+			Priority: schedule.Priority,
+		}
+	}
+
+	if _, err := s.taskCreator.CreateTasksBatch(ctx, &tasksprocessor.BatchCreateTasksRequest{Tasks: inputs}); err != nil {
+		return 0, fmt.Errorf("failed to enqueue tasks: %w", err)
+	}
+
+	lastTick := ticks[len(ticks)-1]
+	if err := s.scheduleRepo.MarkFired(ctx, schedule.ID, lastTick, sp.Next(now)); err != nil {
+		return 0, fmt.Errorf("failed to mark schedule as fired: %w", err)
+	}
+
+	return len(ticks), nil
+}
+
+// dueTicks returns the ticks from schedule.NextRunAt up to now that should fire.
+// Skip keeps only the latest one, catch_up keeps the latest maxCatchUp.
+func (s *Scheduler) dueTicks(sp spec.Spec, schedule *domain.Schedule, now time.Time) []time.Time {
+	keep := 1
+	if schedule.MisfirePolicy == domain.MisfireCatchUp && s.maxCatchUp > 1 {
+		keep = s.maxCatchUp
+	}
+	return spec.Latest(sp, schedule.NextRunAt, now, keep)
+}
+
+// renderPayload substitutes the tick time into the payload template
+func renderPayload(template []byte, tick time.Time) []byte {
+	return bytes.ReplaceAll(
+		template,
+		[]byte(domain.ScheduledAtPlaceholder),
+		[]byte(tick.UTC().Format(time.RFC3339)),
+	)
+}
\ No newline at end of file
@@ -0,0 +1,234 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/usecases/task/creator"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var fixedNow = time.Date(2025, 1, 1, 10, 7, 30, 0, time.UTC)
+
+type mocks struct {
+	repo    *schedulerepo.MockScheduleRepository
+	creator *creator.MockCreator
+	tx      *txmanager.MockTxManager
+	locker  *locker.MockLocker
+}
+
+func newTestScheduler(maxCatchUp int) (*Scheduler, *mocks) {
+	m := &mocks{
+		repo:    new(schedulerepo.MockScheduleRepository),
+		creator: new(creator.MockCreator),
+		tx:      new(txmanager.MockTxManager),
+		locker:  new(locker.MockLocker),
+	}
+	m.tx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	m.tx.On("WithSavepoint", mock.Anything, mock.Anything).Return(nil)
+
+	s := NewScheduler(m.repo, m.creator, m.tx, m.locker, 10, maxCatchUp)
+	s.now = func() time.Time { return fixedNow }
+	return s, m
+}
+
+// tasksOf extracts the enqueued task inputs passed to the creator
+func tasksOf(m *mocks, call int) []tasksprocessor.TaskInput {
+	return m.creator.Calls[call].Arguments.Get(1).(*tasksprocessor.BatchCreateTasksRequest).Tasks
+}
+
+func TestRunDue_LockNotAcquired(t *testing.T) {
+	ctx := context.Background()
+	s, m := newTestScheduler(5)
+	m.locker.On("TryLock", ctx, LockKey).Return(false, nil)
+
+	enqueued, err := s.RunDue(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, enqueued)
+	m.repo.AssertNotCalled(t, "ListDue", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunDue_FiresOnTime(t *testing.T) {
+	ctx := context.Background()
+	s, m := newTestScheduler(5)
+	schedule := &domain.Schedule{
+		ID:            uuid.New(),
+		Name:          "every-minute",
+		CronExpr:      "* * * * *",
+		TaskType:      "report",
+		Payload:       json.RawMessage(`{"at":"{{scheduled_at}}"}`),
+		Priority:      7,
+		MisfirePolicy: domain.MisfireSkip,
+		NextRunAt:     time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC),
+	}
+
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("ListDue", ctx, fixedNow, 10).Return([]*domain.Schedule{schedule}, nil)
+	m.creator.On("CreateTasksBatch", ctx, mock.Anything).Return([]uuid.UUID{uuid.New()}, nil)
+	m.repo.On("MarkFired", ctx, schedule.ID,
+		time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 10, 8, 0, 0, time.UTC),
+	).Return(nil)
+
+	enqueued, err := s.RunDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, enqueued)
+	tasks := tasksOf(m, 0)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "report", tasks[0].Type)
+	assert.Equal(t, 7, tasks[0].Priority)
+	assert.JSONEq(t, `{"at":"2025-01-01T10:07:00Z"}`, string(tasks[0].Payload))
+	m.repo.AssertExpectations(t)
+	m.tx.AssertExpectations(t)
+}
+
+func TestRunDue_SkipMissedTicks(t *testing.T) {
+	ctx := context.Background()
+	s, m := newTestScheduler(5)
+	schedule := &domain.Schedule{
+		ID:            uuid.New(),
+		Interval:      time.Minute,
+		TaskType:      "ping",
+		MisfirePolicy: domain.MisfireSkip,
+		NextRunAt:     fixedNow.Add(-time.Hour),
+	}
+
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("ListDue", ctx, fixedNow, 10).Return([]*domain.Schedule{schedule}, nil)
+	m.creator.On("CreateTasksBatch", ctx, mock.Anything).Return([]uuid.UUID{uuid.New()}, nil)
+	m.repo.On("MarkFired", ctx, schedule.ID, fixedNow, fixedNow.Add(time.Minute)).Return(nil)
+
+	enqueued, err := s.RunDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, enqueued)
+	assert.Len(t, tasksOf(m, 0), 1)
+	m.repo.AssertExpectations(t)
+}
+
+func TestRunDue_CatchUpBounded(t *testing.T) {
+	ctx := context.Background()
+	s, m := newTestScheduler(3)
+	schedule := &domain.Schedule{
+		ID:            uuid.New(),
+		Interval:      time.Minute,
+		TaskType:      "ping",
+		Payload:       json.RawMessage(`{"at":"{{scheduled_at}}"}`),
+		MisfirePolicy: domain.MisfireCatchUp,
+		NextRunAt:     fixedNow.Add(-10 * time.Minute),
+	}
+
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("ListDue", ctx, fixedNow, 10).Return([]*domain.Schedule{schedule}, nil)
+	m.creator.On("CreateTasksBatch", ctx, mock.Anything).Return([]uuid.UUID{uuid.New(), uuid.New(), uuid.New()}, nil)
+	m.repo.On("MarkFired", ctx, schedule.ID, fixedNow, fixedNow.Add(time.Minute)).Return(nil)
+
+	enqueued, err := s.RunDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, enqueued)
+	tasks := tasksOf(m, 0)
+	require.Len(t, tasks, 3)
+	// The latest ticks are kept, oldest first
+	assert.JSONEq(t, `{"at":"2025-01-01T10:05:30Z"}`, string(tasks[0].Payload))
+	assert.JSONEq(t, `{"at":"2025-01-01T10:07:30Z"}`, string(tasks[2].Payload))
+}
+
+func TestRunDue_CreateFails(t *testing.T) {
+	ctx := context.Background()
+	s, m := newTestScheduler(5)
+	schedule := &domain.Schedule{
+		ID:            uuid.New(),
+		CronExpr:      "@hourly",
+		TaskType:      "report",
+		MisfirePolicy: domain.MisfireSkip,
+		NextRunAt:     fixedNow.Add(-time.Minute),
+	}
+
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("ListDue", ctx, fixedNow, 10).Return([]*domain.Schedule{schedule}, nil)
+	m.creator.On("CreateTasksBatch", ctx, mock.Anything).Return([]uuid.UUID(nil), errors.New("db error"))
+
+	enqueued, err := s.RunDue(ctx)
+
+	assert.Error(t, err)
+	assert.Zero(t, enqueued)
+	m.repo.AssertNotCalled(t, "MarkFired", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRunDue_FailingScheduleDoesNotBlockOthers(t *testing.T) {
+	ctx := context.Background()
+	s, m := newTestScheduler(5)
+	broken := &domain.Schedule{
+		ID:            uuid.New(),
+		Name:          "broken",
+		Interval:      time.Minute,
+		TaskType:      "report",
+		MisfirePolicy: domain.MisfireSkip,
+		NextRunAt:     fixedNow,
+	}
+	healthy := &domain.Schedule{
+		ID:            uuid.New(),
+		Name:          "healthy",
+		Interval:      time.Minute,
+		TaskType:      "ping",
+		MisfirePolicy: domain.MisfireSkip,
+		NextRunAt:     fixedNow,
+	}
+	ofType := func(taskType string) any {
+		return mock.MatchedBy(func(r *tasksprocessor.BatchCreateTasksRequest) bool {
+			return r.Tasks[0].Type == taskType
+		})
+	}
+
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("ListDue", ctx, fixedNow, 10).Return([]*domain.Schedule{broken, healthy}, nil)
+	m.creator.On("CreateTasksBatch", ctx, ofType("report")).Return([]uuid.UUID(nil), errors.New("db error"))
+	m.creator.On("CreateTasksBatch", ctx, ofType("ping")).Return([]uuid.UUID{uuid.New()}, nil)
+	m.repo.On("MarkFired", ctx, healthy.ID, fixedNow, fixedNow.Add(time.Minute)).Return(nil)
+
+	enqueued, err := s.RunDue(ctx)
+
+	assert.ErrorContains(t, err, "schedule broken")
+	assert.Equal(t, 1, enqueued)
+	m.repo.AssertExpectations(t)
+	m.repo.AssertNotCalled(t, "MarkFired", mock.Anything, broken.ID, mock.Anything, mock.Anything)
+	m.tx.AssertNumberOfCalls(t, "WithSavepoint", 2)
+}
+
+func TestRunDue_LongOutageFiresLatestTick(t *testing.T) {
+	ctx := context.Background()
+	s, m := newTestScheduler(5)
+	// Tens of millions of missed ticks, computed without stepping through them
+	schedule := &domain.Schedule{
+		ID:            uuid.New(),
+		Interval:      time.Second,
+		TaskType:      "ping",
+		MisfirePolicy: domain.MisfireCatchUp,
+		NextRunAt:     fixedNow.AddDate(-2, 0, 0),
+	}
+
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("ListDue", ctx, fixedNow, 10).Return([]*domain.Schedule{schedule}, nil)
+	m.creator.On("CreateTasksBatch", ctx, mock.Anything).Return([]uuid.UUID{uuid.New()}, nil)
+	m.repo.On("MarkFired", ctx, schedule.ID, fixedNow, fixedNow.Add(time.Second)).Return(nil)
+
+	enqueued, err := s.RunDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, enqueued)
+	m.repo.AssertExpectations(t)
+}
\ No newline at end of file
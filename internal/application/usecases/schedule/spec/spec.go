@@ -0,0 +1,111 @@
+package spec
+
+import (
+	"fmt"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// MinInterval is the shortest interval a schedule may fire at
+const MinInterval = time.Second
+
+// parser accepts standard 5-field expressions and descriptors such as @hourly
+var parser = cron.NewParser(
+	cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Spec computes the ticks of a schedule
+type Spec interface {
+	// Next returns the first tick strictly after t
+	Next(t time.Time) time.Time
+}
+
+// Parse builds the spec of a schedule from its cron expression or interval.
+// Exactly one of them must be set, otherwise domain.ErrInvalidSchedule is returned.
+func Parse(cronExpr string, interval time.Duration) (Spec, error) {
+	switch {
+	case cronExpr != "" && interval != 0:
+		return nil, fmt.Errorf("%w: cron expression and interval are mutually exclusive", domain.ErrInvalidSchedule)
+	case cronExpr != "":
+		schedule, err := parser.Parse(cronExpr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidSchedule, err)
+		}
+		// Expressions such as "0 0 30 2 *" parse but never fire
+		if schedule.Next(time.Now()).IsZero() {
+			return nil, fmt.Errorf("%w: cron expression never fires", domain.ErrInvalidSchedule)
+		}
+		return schedule, nil
+	case interval >= MinInterval:
+		return intervalSpec(interval), nil
+	case interval != 0:
+		return nil, fmt.Errorf("%w: interval must be at least %s", domain.ErrInvalidSchedule, MinInterval)
+	default:
+		return nil, fmt.Errorf("%w: either cron expression or interval is required", domain.ErrInvalidSchedule)
+	}
+}
+
+// ForSchedule parses the spec of a stored schedule
+func ForSchedule(schedule *domain.Schedule) (Spec, error) {
+	return Parse(schedule.CronExpr, schedule.Interval)
+}
+
+// intervalSpec fires every fixed duration, counted from the previous tick
+type intervalSpec time.Duration
+
+func (s intervalSpec) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(s))
+}
+
+// lookbackStart is the first window searched back from now for the latest ticks
+const lookbackStart = time.Minute
+
+// Latest returns the last n ticks of sp from the tick from up to now, oldest first.
+// The work is bounded by n rather than by the number of ticks missed since from.
+func Latest(sp Spec, from, now time.Time, n int) []time.Time {
+	if from.IsZero() || from.After(now) || n <= 0 {
+		return nil
+	}
+	if interval, ok := sp.(intervalSpec); ok {
+		return latestIntervals(time.Duration(interval), from, now, n)
+	}
+
+	// Search windows ending at now, doubling them until they hold n ticks or reach from
+	for window := lookbackStart; ; window *= 2 {
+		start := now.Add(-window)
+		if !start.After(from) {
+			return ticksBetween(sp, from, now, n)
+		}
+		if ticks := ticksBetween(sp, sp.Next(start), now, n); len(ticks) == n {
+			return ticks
+		}
+	}
+}
+
+// latestIntervals computes the last n ticks of a fixed interval directly
+func latestIntervals(interval time.Duration, from, now time.Time, n int) []time.Time {
+	last := int64(now.Sub(from) / interval)
+	first := max(last-int64(n-1), 0)
+
+	ticks := make([]time.Time, 0, last-first+1)
+	for i := first; i <= last; i++ {
+		ticks = append(ticks, from.Add(time.Duration(i)*interval))
+	}
+	return ticks
+}
+
+// ticksBetween walks the ticks from first up to now and keeps the last n of them
+func ticksBetween(sp Spec, first, now time.Time, n int) []time.Time {
+	ticks := make([]time.Time, 0, n)
+	for tick := first; !tick.IsZero() && !tick.After(now); tick = sp.Next(tick) {
+		if len(ticks) < n {
+			ticks = append(ticks, tick)
+			continue
+		}
+		copy(ticks, ticks[1:])
+		ticks[n-1] = tick
+	}
+	return ticks
+}
\ No newline at end of file
@@ -0,0 +1,97 @@
+package spec
+
+import (
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Cron(t *testing.T) {
+	s, err := Parse("*/15 * * * *", 0)
+	require.NoError(t, err)
+
+	from := time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC), s.Next(from))
+}
+
+func TestParse_Descriptor(t *testing.T) {
+	s, err := Parse("@hourly", 0)
+	require.NoError(t, err)
+
+	from := time.Date(2025, 1, 1, 10, 7, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC), s.Next(from))
+}
+
+func TestParse_Interval(t *testing.T) {
+	s, err := Parse("", 90*time.Second)
+	require.NoError(t, err)
+
+	from := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, from.Add(90*time.Second), s.Next(from))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	cases := []struct {
+		name     string
+		cronExpr string
+		interval time.Duration
+	}{
+		{"none", "", 0},
+		{"both", "* * * * *", time.Minute},
+		{"bad cron", "not a cron", 0},
+		{"seconds field", "0 * * * * *", 0},
+		{"never fires", "0 0 30 2 *", 0},
+		{"too short interval", "", 500 * time.Millisecond},
+		{"negative interval", "", -time.Minute},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(tc.cronExpr, tc.interval)
+			assert.ErrorIs(t, err, domain.ErrInvalidSchedule)
+		})
+	}
+}
+
+func TestLatest_Interval(t *testing.T) {
+	s, err := Parse("", time.Minute)
+	require.NoError(t, err)
+
+	from := time.Date(2025, 1, 1, 10, 0, 30, 0, time.UTC)
+	now := time.Date(2025, 1, 1, 10, 10, 0, 0, time.UTC)
+
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 1, 1, 10, 7, 30, 0, time.UTC),
+		time.Date(2025, 1, 1, 10, 8, 30, 0, time.UTC),
+		time.Date(2025, 1, 1, 10, 9, 30, 0, time.UTC),
+	}, Latest(s, from, now, 3))
+	assert.Equal(t, []time.Time{from}, Latest(s, from, from, 3))
+	assert.Empty(t, Latest(s, now, from, 3))
+}
+
+func TestLatest_Cron(t *testing.T) {
+	s, err := Parse("*/15 * * * *", 0)
+	require.NoError(t, err)
+
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 1, 1, 10, 50, 0, 0, time.UTC)
+
+	assert.Equal(t, []time.Time{
+		time.Date(2025, 1, 1, 10, 15, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC),
+		time.Date(2025, 1, 1, 10, 45, 0, 0, time.UTC),
+	}, Latest(s, from, now, 3))
+}
+
+func TestLatest_CronFewerTicksThanRequested(t *testing.T) {
+	s, err := Parse("@hourly", 0)
+	require.NoError(t, err)
+
+	from := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	now := time.Date(2025, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, []time.Time{from, time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)}, Latest(s, from, now, 5))
+}
\ No newline at end of file
@@ -0,0 +1,54 @@
+package schedule
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/usecases/schedule/manager"
+	"task-processor/internal/application/usecases/schedule/scheduler"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type UseCases struct {
+	Manager   Manager
+	Scheduler Scheduler
+}
+
+// Settings holds the tunables of the schedule use cases
+type Settings struct {
+	// BatchSize limits how many due schedules are fired per run
+	BatchSize  int
+	// MaxCatchUp limits how many missed ticks a catch_up schedule fires at once
+	MaxCatchUp int
+}
+
+func NewUseCases(
+	scheduleRepo schedulerepo.ScheduleRepository,
+	taskCreator  scheduler.TaskCreator,
+	txManager    txmanager.TxManager,
+	locker       locker.Locker,
+	settings     Settings,
+) *UseCases {
+
+	return &UseCases{
+		Manager:   manager.NewManager(scheduleRepo),
+		Scheduler: scheduler.NewScheduler(
+			scheduleRepo, taskCreator, txManager, locker, settings.BatchSize, settings.MaxCatchUp,
+		),
+	}
+}
+
+type Manager interface {
+	Create(ctx context.Context, input *tasksprocessor.ScheduleInput) (*domain.Schedule, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.Schedule, error)
+	List(ctx context.Context) ([]*domain.Schedule, error)
+	Update(ctx context.Context, id uuid.UUID, input *tasksprocessor.ScheduleInput) (*domain.Schedule, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+type Scheduler interface {
+	RunDue(ctx context.Context) (int, error)
+}
\ No newline at end of file
@@ -4,18 +4,25 @@ import (
 	"context"
 	"fmt"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/usecases/task/stream"
 	"task-processor/internal/domain"
 )
 
 type Acquirer struct {
 	taskRepo           taskrepo.TaskRepository
+	lease              domain.Lease
+	stream             *stream.Emitter
 }
 
 func NewAcquirer(
 	taskRepo 	   taskrepo.TaskRepository,
+	lease          domain.Lease,
+	stream         *stream.Emitter,
 ) *Acquirer {
 	return &Acquirer{
 		taskRepo:           taskRepo,
+		lease:              lease,
+		stream:             stream,
 	}
 }
 
@@ -23,9 +30,10 @@ func (a *Acquirer) AcquireTasks(
 	ctx context.Context,
 	limit int,
 ) ([]*domain.Task, error) {
-	tasks, err := a.taskRepo.AcquireTasks(ctx, limit)
+	tasks, err := a.taskRepo.AcquireTasks(ctx, limit, a.lease)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire tasks: %w", err)
 	}
+	a.stream.Emit(domain.EventAcquired, tasks...)
 	return tasks, nil
 }
\ No newline at end of file
@@ -3,24 +3,34 @@ package acquirer
 import (
 	"context"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/ports/outbound/taskstream"
+	"task-processor/internal/application/usecases/task/stream"
 	"task-processor/internal/domain"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestAcquireTasks_Success(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(taskrepo.MockTaskRepository)
+	lease := domain.Lease{Owner: "worker-1", Duration: time.Minute}
 	
 	tasks := []*domain.Task{{ID: uuid.New()}, {ID: uuid.New()}}
-	mockRepo.On("AcquireTasks", ctx, 2).Return(tasks, nil)
+	mockRepo.On("AcquireTasks", ctx, 2, lease).Return(tasks, nil)
 
-	aq := NewAcquirer(mockRepo)
+	publisher := new(taskstream.MockPublisher)
+	publisher.On("Publish", mock.Anything).Return()
+
+	aq := NewAcquirer(mockRepo, lease, stream.NewEmitter(publisher))
 	result, err := aq.AcquireTasks(ctx, 2)
 
 	assert.NoError(t, err)
 	assert.Equal(t, tasks, result)
 	mockRepo.AssertExpectations(t)
-}
+	publisher.AssertNumberOfCalls(t, "Publish", 2)
+	assert.Equal(t, domain.EventAcquired, publisher.Calls[0].Arguments.Get(0).(*domain.StreamEvent).Type)
+}
\ No newline at end of file
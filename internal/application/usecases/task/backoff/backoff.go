@@ -0,0 +1,85 @@
+package backoff
+
+import (
+	"fmt"
+	"math"
+	"task-processor/internal/application/ports/inbound/random"
+	"time"
+)
+
+// Jitter defines how randomness is applied to the computed delay
+type Jitter string
+
+const (
+	// JitterNone uses the exponential delay as is
+	JitterNone  Jitter = "none"
+	// JitterFull picks a delay uniformly in [0, delay]
+	JitterFull  Jitter = "full"
+	// JitterEqual keeps half of the delay and randomizes the other half
+	JitterEqual Jitter = "equal"
+)
+
+// Config describes an exponential backoff: Base * Multiplier^(attempt-1), capped at Cap
+type Config struct {
+	Base       time.Duration
+	Multiplier float64
+	Cap        time.Duration
+	Jitter     Jitter
+}
+
+// Validate reports configuration values the policy cannot work with
+func (c Config) Validate() error {
+	switch c.Jitter {
+	case JitterNone, JitterFull, JitterEqual:
+	default:
+		return fmt.Errorf("unknown backoff jitter %q", c.Jitter)
+	}
+	if c.Base < 0 || c.Cap < 0 {
+		return fmt.Errorf("backoff durations must not be negative")
+	}
+	if c.Multiplier < 1 {
+		return fmt.Errorf("backoff multiplier must be at least 1, got %v", c.Multiplier)
+	}
+	return nil
+}
+
+// Policy computes the delay before the next retry of a failed task
+type Policy struct {
+	cfg            Config
+	randomProvider random.RandomProvider
+}
+
+func NewPolicy(
+	cfg            Config,
+	randomProvider random.RandomProvider,
+) *Policy {
+	return &Policy{
+		cfg:            cfg,
+		randomProvider: randomProvider,
+	}
+}
+
+// Delay returns how long to wait after the given (1-based) failed attempt
+func (p *Policy) Delay(attempt int) time.Duration {
+	if p.cfg.Base <= 0 {
+		return 0
+	}
+
+	delay := float64(p.cfg.Base) * math.Pow(p.cfg.Multiplier, float64(max(attempt-1, 0)))
+	if p.cfg.Cap > 0 && delay > float64(p.cfg.Cap) {
+		delay = float64(p.cfg.Cap)
+	}
+	// Guard against overflow when no cap is configured
+	if delay > math.MaxInt64 {
+		delay = math.MaxInt64
+	}
+
+	switch p.cfg.Jitter {
+	case JitterFull:
+		delay = p.randomProvider.Float64() * delay
+	case JitterEqual:
+		delay = delay/2 + p.randomProvider.Float64()*delay/2
+	}
+
+	return time.Duration(delay)
+}
\ No newline at end of file
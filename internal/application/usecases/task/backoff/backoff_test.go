@@ -0,0 +1,73 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"task-processor/internal/application/ports/inbound/random"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelay_Exponential(t *testing.T) {
+	policy := NewPolicy(Config{
+		Base:       time.Second,
+		Multiplier: 2,
+		Cap:        10 * time.Second,
+		Jitter:     JitterNone,
+	}, new(random.MockRandom))
+
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+	assert.Equal(t, 4*time.Second, policy.Delay(3))
+	assert.Equal(t, 8*time.Second, policy.Delay(4))
+	assert.Equal(t, 10*time.Second, policy.Delay(5))
+	assert.Equal(t, 10*time.Second, policy.Delay(100))
+}
+
+func TestDelay_FullJitter(t *testing.T) {
+	mockRand := new(random.MockRandom)
+	mockRand.On("Float64").Return(0.25)
+
+	policy := NewPolicy(Config{
+		Base:       4 * time.Second,
+		Multiplier: 2,
+		Cap:        time.Minute,
+		Jitter:     JitterFull,
+	}, mockRand)
+
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+}
+
+func TestDelay_EqualJitter(t *testing.T) {
+	mockRand := new(random.MockRandom)
+	mockRand.On("Float64").Return(0.5)
+
+	policy := NewPolicy(Config{
+		Base:       4 * time.Second,
+		Multiplier: 2,
+		Cap:        time.Minute,
+		Jitter:     JitterEqual,
+	}, mockRand)
+
+	assert.Equal(t, 6*time.Second, policy.Delay(2))
+}
+
+func TestDelay_Disabled(t *testing.T) {
+	policy := NewPolicy(Config{Multiplier: 2, Jitter: JitterFull}, new(random.MockRandom))
+
+	assert.Equal(t, time.Duration(0), policy.Delay(3))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	valid := Config{Base: time.Second, Multiplier: 2, Cap: time.Minute, Jitter: JitterEqual}
+	assert.NoError(t, valid.Validate())
+
+	badJitter := valid
+	badJitter.Jitter = "random"
+	assert.Error(t, badJitter.Validate())
+
+	badMultiplier := valid
+	badMultiplier.Multiplier = 0.5
+	assert.Error(t, badMultiplier.Validate())
+}
\ No newline at end of file
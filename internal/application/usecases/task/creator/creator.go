@@ -2,29 +2,76 @@ package creator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/usecases/task/outbox"
 	"task-processor/internal/domain"
+	"time"
 	"github.com/google/uuid"
 )
 
+var emptyPayload = json.RawMessage(`{}`)
+
 type Creator struct {
-	taskRepo taskrepo.TaskRepository
+	taskRepo  taskrepo.TaskRepository
+	txManager txmanager.TxManager
+	outbox    *outbox.Writer
 }
 
-func NewCreator(taskRepo taskrepo.TaskRepository) *Creator {
-	return &Creator{taskRepo: taskRepo}
+func NewCreator(
+	taskRepo  taskrepo.TaskRepository,
+	txManager txmanager.TxManager,
+	outbox    *outbox.Writer,
+) *Creator {
+	return &Creator{
+		taskRepo:  taskRepo,
+		txManager: txManager,
+		outbox:    outbox,
+	}
 }
 
-func (c *Creator) CreateTasksBatch(ctx context.Context, count int) ([]uuid.UUID, error) {
-	tasks := make([]*domain.Task, count)
-	for i := 0; i < count; i++ {
-		tasks[i] = &domain.Task{Status: domain.StatusNew}
+func (c *Creator) CreateTasksBatch(
+	ctx context.Context,
+	request *tasksprocessor.BatchCreateTasksRequest,
+) ([]uuid.UUID, error) {
+	tasks := make([]*domain.Task, len(request.Tasks))
+	for i, input := range request.Tasks {
+		payload := input.Payload
+		if len(payload) == 0 {
+			payload = emptyPayload
+		}
+		runAt := input.RunAt
+		if runAt == nil && input.Delay > 0 {
+			due := time.Now().Add(input.Delay)
+			runAt = &due
+		}
+		tasks[i] = &domain.Task{
+			Type:     input.Type,
+			Payload:  payload,
+			Priority: input.Priority,
+			RunAt:    runAt,
+			Status:   domain.StatusNew,
+			CallbackURL: input.CallbackURL,
+		}
 	}
 
-	ids, err := c.taskRepo.BatchCreate(ctx, tasks)
+	var ids []uuid.UUID
+	err := c.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		ids, err = c.taskRepo.BatchCreate(ctx, tasks)
+		if err != nil {
+			return fmt.Errorf("failed to create tasks batch: %w", err)
+		}
+		for i, id := range ids {
+			tasks[i].ID = id
+		}
+		return c.outbox.Record(ctx, domain.EventCreated, tasks...)
+	})
 	if err != nil {
-		return ids, fmt.Errorf("failed to create tasks batch: %w", err)
+		return nil, err
 	}
 
 	return ids, nil
@@ -2,16 +2,37 @@ package creator
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/usecases/task/outbox"
 	"task-processor/internal/domain"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+var testOutbox = outbox.NewWriter(nil, false)
+
+func newTestCreator(taskRepo taskrepo.TaskRepository) *Creator {
+	mockTx := new(txmanager.MockTxManager)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	return NewCreator(taskRepo, mockTx, testOutbox)
+}
+
+func newBatchRequest(count int) *tasksprocessor.BatchCreateTasksRequest {
+	tasks := make([]tasksprocessor.TaskInput, count)
+	for i := range tasks {
+		tasks[i] = tasksprocessor.TaskInput{Type: "test", Payload: json.RawMessage(`{"n":1}`)}
+	}
+	return &tasksprocessor.BatchCreateTasksRequest{Tasks: tasks}
+}
+
 func TestTaskCreator_CreateTasksBatch(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(taskrepo.MockTaskRepository)
@@ -23,9 +44,9 @@ func TestTaskCreator_CreateTasksBatch(t *testing.T) {
 		return len(tasks) == taskCount
 	})).Return(expectedIDs, nil)
 
-	creator := NewCreator(mockRepo)
+	creator := newTestCreator(mockRepo)
 
-	ids, err := creator.CreateTasksBatch(ctx, taskCount)
+	ids, err := creator.CreateTasksBatch(ctx, newBatchRequest(taskCount))
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedIDs, ids)
@@ -41,9 +62,9 @@ func TestTaskCreator_CreateTasksBatch_Error(t *testing.T) {
 
 	mockRepo.On("BatchCreate", ctx, mock.Anything).Return([]uuid.UUID(nil), errors.New("db error"))
 
-	creator := NewCreator(mockRepo)
+	creator := newTestCreator(mockRepo)
 
-	ids, err := creator.CreateTasksBatch(ctx, taskCount)
+	ids, err := creator.CreateTasksBatch(ctx, newBatchRequest(taskCount))
 
 	assert.Error(t, err)
 	assert.Nil(t, ids)
@@ -56,9 +77,9 @@ func TestTaskCreator_CreateTasksBatch_ZeroTasks(t *testing.T) {
 
 	mockRepo.On("BatchCreate", ctx, []*domain.Task{}).Return([]uuid.UUID{}, nil)
 	
-	creator := NewCreator(mockRepo)
+	creator := newTestCreator(mockRepo)
 
-	ids, err := creator.CreateTasksBatch(ctx, 0)
+	ids, err := creator.CreateTasksBatch(ctx, newBatchRequest(0))
 
 	assert.NoError(t, err)
 	assert.Empty(t, ids)
@@ -81,11 +102,89 @@ func TestTaskCreator_CreateTasksBatch_StatusCheck(t *testing.T) {
 		return true
 	})).Return(expectedIDs, nil)
 
-	creator := NewCreator(mockRepo)
+	creator := newTestCreator(mockRepo)
 
-	ids, err := creator.CreateTasksBatch(ctx, taskCount)
+	ids, err := creator.CreateTasksBatch(ctx, newBatchRequest(taskCount))
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedIDs, ids)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestTaskCreator_CreateTasksBatch_TypeAndPayload(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	request := &tasksprocessor.BatchCreateTasksRequest{
+		Tasks: []tasksprocessor.TaskInput{
+			{Type: "email", Payload: json.RawMessage(`{"to":"user@example.com"}`)},
+			{Type: "report"},
+		},
+	}
+
+	mockRepo.On("BatchCreate", ctx, mock.MatchedBy(func(tasks []*domain.Task) bool {
+		return len(tasks) == 2 &&
+			tasks[0].Type == "email" && string(tasks[0].Payload) == `{"to":"user@example.com"}` &&
+			tasks[1].Type == "report" && string(tasks[1].Payload) == `{}`
+	})).Return([]uuid.UUID{uuid.New(), uuid.New()}, nil)
+
+	creator := newTestCreator(mockRepo)
+
+	ids, err := creator.CreateTasksBatch(ctx, request)
+
+	assert.NoError(t, err)
+	assert.Len(t, ids, 2)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTaskCreator_CreateTasksBatch_Priority(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	request := &tasksprocessor.BatchCreateTasksRequest{
+		Tasks: []tasksprocessor.TaskInput{
+			{Type: "email", Priority: 100},
+			{Type: "email"},
+		},
+	}
+
+	mockRepo.On("BatchCreate", ctx, mock.MatchedBy(func(tasks []*domain.Task) bool {
+		return len(tasks) == 2 && tasks[0].Priority == 100 && tasks[1].Priority == 0
+	})).Return([]uuid.UUID{uuid.New(), uuid.New()}, nil)
+
+	creator := newTestCreator(mockRepo)
+
+	_, err := creator.CreateTasksBatch(ctx, request)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestTaskCreator_CreateTasksBatch_RunAtAndDelay(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	runAt := time.Date(2030, 1, 1, 2, 0, 0, 0, time.UTC)
+	request := &tasksprocessor.BatchCreateTasksRequest{
+		Tasks: []tasksprocessor.TaskInput{
+			{Type: "report", RunAt: &runAt},
+			{Type: "report", Delay: 10 * time.Minute},
+			{Type: "report"},
+		},
+	}
+
+	before := time.Now()
+	mockRepo.On("BatchCreate", ctx, mock.MatchedBy(func(tasks []*domain.Task) bool {
+		return len(tasks) == 3 &&
+			tasks[0].RunAt.Equal(runAt) &&
+			tasks[1].RunAt != nil && !tasks[1].RunAt.Before(before.Add(10*time.Minute)) &&
+			tasks[2].RunAt == nil
+	})).Return([]uuid.UUID{uuid.New(), uuid.New(), uuid.New()}, nil)
+
+	creator := newTestCreator(mockRepo)
+
+	_, err := creator.CreateTasksBatch(ctx, request)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
\ No newline at end of file
@@ -2,6 +2,7 @@ package creator
 
 import (
 	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/mock"
 )
@@ -10,7 +11,7 @@ type MockCreator struct {
 	mock.Mock
 }
 
-func (m *MockCreator) CreateTasksBatch(ctx context.Context, count int) ([]uuid.UUID, error) {
-	args := m.Called(ctx, count)
+func (m *MockCreator) CreateTasksBatch(ctx context.Context, request *tasksprocessor.BatchCreateTasksRequest) ([]uuid.UUID, error) {
+	args := m.Called(ctx, request)
 	return args.Get(0).([]uuid.UUID), args.Error(1)
-}
+}
\ No newline at end of file
@@ -0,0 +1,50 @@
+package cursor
+
+import (
+	"encoding/base64"
+	"strings"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Encode turns a keyset position into an opaque URL-safe token
+func Encode(cursor domain.TaskCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode parses a token produced by Encode.
+// Malformed tokens are reported as domain.ErrInvalidCursor.
+func Decode(token string) (*domain.TaskCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	cursor := &domain.TaskCursor{}
+	if cursor.CreatedAt, err = time.Parse(time.RFC3339Nano, createdAt); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+	if cursor.ID, err = uuid.Parse(id); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+	return cursor, nil
+}
+
+// Paginate trims tasks fetched with limit+1 to limit and returns
+// the cursor of the next page, or an empty string on the last page
+func Paginate(tasks []*domain.Task, limit int) ([]*domain.Task, string) {
+	if len(tasks) <= limit {
+		return tasks, ""
+	}
+	tasks = tasks[:limit]
+	last := tasks[len(tasks)-1]
+	return tasks, Encode(domain.TaskCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+}
\ No newline at end of file
@@ -0,0 +1,46 @@
+package cursor
+
+import (
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	original := domain.TaskCursor{
+		CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 123456000, time.UTC),
+		ID:        uuid.New(),
+	}
+
+	decoded, err := Decode(Encode(original))
+
+	require.NoError(t, err)
+	assert.Equal(t, original.ID, decoded.ID)
+	assert.True(t, original.CreatedAt.Equal(decoded.CreatedAt))
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	// not base64, no separator, bad time
+	for _, token := range []string{"%%%", "bm8tc2VwYXJhdG9y", "eHx5"} {
+		_, err := Decode(token)
+		assert.ErrorIs(t, err, domain.ErrInvalidCursor, token)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	tasks := []*domain.Task{{ID: uuid.New()}, {ID: uuid.New()}, {ID: uuid.New()}}
+
+	page, next := Paginate(tasks, 3)
+	assert.Len(t, page, 3)
+	assert.Empty(t, next)
+
+	page, next = Paginate(tasks, 2)
+	assert.Len(t, page, 2)
+	decoded, err := Decode(next)
+	require.NoError(t, err)
+	assert.Equal(t, tasks[1].ID, decoded.ID)
+}
\ No newline at end of file
@@ -0,0 +1,130 @@
+package deadletter
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/usecases/task/cursor"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// DeadLetter lets operators inspect, requeue and purge tasks in the dead-letter queue
+type DeadLetter struct {
+	taskRepo       taskrepo.TaskRepository
+	failedTaskRepo failedtaskrepo.FailedTaskRepository
+	txManager      txmanager.TxManager
+}
+
+func NewDeadLetter(
+	taskRepo       taskrepo.TaskRepository,
+	failedTaskRepo failedtaskrepo.FailedTaskRepository,
+	txManager      txmanager.TxManager,
+) *DeadLetter {
+	return &DeadLetter{
+		taskRepo:       taskRepo,
+		failedTaskRepo: failedTaskRepo,
+		txManager:      txManager,
+	}
+}
+
+// ListTasks returns one page of dead-lettered tasks matching the request.
+// A malformed cursor is reported as domain.ErrInvalidCursor.
+func (d *DeadLetter) ListTasks(ctx context.Context, request *tasksprocessor.ListDeadLetterRequest) (*tasksprocessor.TaskPage, error) {
+	filter := toTaskFilter(request.Filter, request.Limit+1)
+
+	if request.Cursor != "" {
+		after, err := cursor.Decode(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+
+	tasks, err := d.failedTaskRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dead-lettered tasks: %w", err)
+	}
+
+	page := &tasksprocessor.TaskPage{}
+	page.Tasks, page.NextCursor = cursor.Paginate(tasks, request.Limit)
+	return page, nil
+}
+
+// GetTask returns a dead-lettered task or a wrapped domain.ErrTaskNotFound
+func (d *DeadLetter) GetTask(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	task, err := d.failedTaskRepo.Get(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead-lettered task %s: %w", taskID, err)
+	}
+	return task, nil
+}
+
+// Requeue moves a dead-lettered task back into the queue with attempts reset.
+// The move is atomic: the task is never in both tables nor in neither.
+func (d *DeadLetter) Requeue(ctx context.Context, taskID uuid.UUID) error {
+	return d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		task, err := d.failedTaskRepo.Remove(ctx, taskID)
+		if err != nil {
+			return fmt.Errorf("failed to remove dead-lettered task %s: %w", taskID, err)
+		}
+		if err := d.taskRepo.Restore(ctx, []*domain.Task{task}); err != nil {
+			return fmt.Errorf("failed to restore task %s: %w", taskID, err)
+		}
+		return nil
+	})
+}
+
+// RequeueMatching moves up to request.Limit matching tasks back into the queue
+// in a single transaction and reports how many were moved
+func (d *DeadLetter) RequeueMatching(ctx context.Context, request *tasksprocessor.DeadLetterBulkRequest) (int, error) {
+	var moved int
+
+	err := d.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		tasks, err := d.failedTaskRepo.RemoveMatching(ctx, toTaskFilter(request.Filter, request.Limit))
+		if err != nil {
+			return fmt.Errorf("failed to remove dead-lettered tasks: %w", err)
+		}
+		if err := d.taskRepo.Restore(ctx, tasks); err != nil {
+			return fmt.Errorf("failed to restore tasks: %w", err)
+		}
+		moved = len(tasks)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
+
+// Purge permanently deletes a dead-lettered task
+func (d *DeadLetter) Purge(ctx context.Context, taskID uuid.UUID) error {
+	if _, err := d.failedTaskRepo.Remove(ctx, taskID); err != nil {
+		return fmt.Errorf("failed to purge dead-lettered task %s: %w", taskID, err)
+	}
+	return nil
+}
+
+// PurgeMatching permanently deletes up to request.Limit matching tasks and reports how many were deleted
+func (d *DeadLetter) PurgeMatching(ctx context.Context, request *tasksprocessor.DeadLetterBulkRequest) (int, error) {
+	tasks, err := d.failedTaskRepo.RemoveMatching(ctx, toTaskFilter(request.Filter, request.Limit))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge dead-lettered tasks: %w", err)
+	}
+	return len(tasks), nil
+}
+
+func toTaskFilter(filter tasksprocessor.DeadLetterFilter, limit int) domain.TaskFilter {
+	return domain.TaskFilter{
+		Type:          filter.Type,
+		CreatedFrom:   filter.CreatedFrom,
+		CreatedTo:     filter.CreatedTo,
+		ErrorContains: filter.ErrorContains,
+		Limit:         limit,
+	}
+}
\ No newline at end of file
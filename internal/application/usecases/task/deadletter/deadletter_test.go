@@ -0,0 +1,140 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mocks struct {
+	repo       *taskrepo.MockTaskRepository
+	failedRepo *failedtaskrepo.MockFailedTaskRepo
+	tx         *txmanager.MockTxManager
+}
+
+func newTestDeadLetter() (*DeadLetter, *mocks) {
+	m := &mocks{
+		repo:       new(taskrepo.MockTaskRepository),
+		failedRepo: new(failedtaskrepo.MockFailedTaskRepo),
+		tx:         new(txmanager.MockTxManager),
+	}
+	m.tx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	return NewDeadLetter(m.repo, m.failedRepo, m.tx), m
+}
+
+func TestListTasks_AppliesFilter(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDeadLetter()
+
+	tasks := []*domain.Task{{ID: uuid.New()}}
+	m.failedRepo.On("List", ctx, domain.TaskFilter{Type: "email", ErrorContains: "timeout", Limit: 11}).Return(tasks, nil)
+
+	page, err := d.ListTasks(ctx, &tasksprocessor.ListDeadLetterRequest{
+		Filter: tasksprocessor.DeadLetterFilter{Type: "email", ErrorContains: "timeout"},
+		Limit:  10,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, tasks, page.Tasks)
+	assert.Empty(t, page.NextCursor)
+	m.failedRepo.AssertExpectations(t)
+}
+
+func TestRequeue_MovesTaskInTransaction(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDeadLetter()
+
+	task := &domain.Task{ID: uuid.New(), Status: domain.StatusFailed, Attempts: 3}
+	m.failedRepo.On("Remove", ctx, task.ID).Return(task, nil)
+	m.repo.On("Restore", ctx, []*domain.Task{task}).Return(nil)
+
+	err := d.Requeue(ctx, task.ID)
+
+	require.NoError(t, err)
+	m.tx.AssertExpectations(t)
+	m.failedRepo.AssertExpectations(t)
+	m.repo.AssertExpectations(t)
+}
+
+func TestRequeue_NotFound(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDeadLetter()
+
+	id := uuid.New()
+	m.failedRepo.On("Remove", ctx, id).Return(nil, domain.ErrTaskNotFound)
+
+	err := d.Requeue(ctx, id)
+
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+	m.repo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestRequeue_RestoreFails(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDeadLetter()
+
+	task := &domain.Task{ID: uuid.New()}
+	m.failedRepo.On("Remove", ctx, task.ID).Return(task, nil)
+	m.repo.On("Restore", ctx, mock.Anything).Return(errors.New("db error"))
+
+	err := d.Requeue(ctx, task.ID)
+
+	assert.Error(t, err)
+}
+
+func TestRequeueMatching(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDeadLetter()
+
+	tasks := []*domain.Task{{ID: uuid.New()}, {ID: uuid.New()}}
+	m.failedRepo.On("RemoveMatching", ctx, domain.TaskFilter{Type: "email", Limit: 100}).Return(tasks, nil)
+	m.repo.On("Restore", ctx, tasks).Return(nil)
+
+	moved, err := d.RequeueMatching(ctx, &tasksprocessor.DeadLetterBulkRequest{
+		Filter: tasksprocessor.DeadLetterFilter{Type: "email"},
+		Limit:  100,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, moved)
+	m.tx.AssertExpectations(t)
+}
+
+func TestPurgeMatching(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDeadLetter()
+
+	tasks := []*domain.Task{{ID: uuid.New()}}
+	m.failedRepo.On("RemoveMatching", ctx, domain.TaskFilter{ErrorContains: "bad", Limit: 5}).Return(tasks, nil)
+
+	purged, err := d.PurgeMatching(ctx, &tasksprocessor.DeadLetterBulkRequest{
+		Filter: tasksprocessor.DeadLetterFilter{ErrorContains: "bad"},
+		Limit:  5,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	m.repo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestPurge_NotFound(t *testing.T) {
+	ctx := context.Background()
+	d, m := newTestDeadLetter()
+
+	id := uuid.New()
+	m.failedRepo.On("Remove", ctx, id).Return(nil, domain.ErrTaskNotFound)
+
+	err := d.Purge(ctx, id)
+
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
\ No newline at end of file
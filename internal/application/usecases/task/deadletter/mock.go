@@ -0,0 +1,52 @@
+package deadletter
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDeadLetter struct {
+	mock.Mock
+}
+
+func (m *MockDeadLetter) ListTasks(ctx context.Context, request *tasksprocessor.ListDeadLetterRequest) (*tasksprocessor.TaskPage, error) {
+	args := m.Called(ctx, request)
+	var page *tasksprocessor.TaskPage
+	if p := args.Get(0); p != nil {
+		page = p.(*tasksprocessor.TaskPage)
+	}
+	return page, args.Error(1)
+}
+
+func (m *MockDeadLetter) GetTask(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	args := m.Called(ctx, taskID)
+	var task *domain.Task
+	if t := args.Get(0); t != nil {
+		task = t.(*domain.Task)
+	}
+	return task, args.Error(1)
+}
+
+func (m *MockDeadLetter) Requeue(ctx context.Context, taskID uuid.UUID) error {
+	args := m.Called(ctx, taskID)
+	return args.Error(0)
+}
+
+func (m *MockDeadLetter) RequeueMatching(ctx context.Context, request *tasksprocessor.DeadLetterBulkRequest) (int, error) {
+	args := m.Called(ctx, request)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockDeadLetter) Purge(ctx context.Context, taskID uuid.UUID) error {
+	args := m.Called(ctx, taskID)
+	return args.Error(0)
+}
+
+func (m *MockDeadLetter) PurgeMatching(ctx context.Context, request *tasksprocessor.DeadLetterBulkRequest) (int, error) {
+	args := m.Called(ctx, request)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
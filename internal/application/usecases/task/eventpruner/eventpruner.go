@@ -0,0 +1,43 @@
+package eventpruner
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
+	"time"
+)
+
+// Pruner deletes task events older than the retention period
+type Pruner struct {
+	taskEventRepo taskeventrepo.TaskEventRepository
+	retention     time.Duration
+	batchSize     int
+	now           func() time.Time
+}
+
+// NewPruner creates the use case; a zero retention keeps events forever
+func NewPruner(
+	taskEventRepo taskeventrepo.TaskEventRepository,
+	retention     time.Duration,
+	batchSize     int,
+) *Pruner {
+	return &Pruner{
+		taskEventRepo: taskEventRepo,
+		retention:     retention,
+		batchSize:     batchSize,
+		now:           time.Now,
+	}
+}
+
+// PruneExpired deletes up to one batch of expired events and reports how many were deleted
+func (p *Pruner) PruneExpired(ctx context.Context) (int, error) {
+	if p.retention <= 0 {
+		return 0, nil
+	}
+
+	deleted, err := p.taskEventRepo.DeleteOlderThan(ctx, p.now().Add(-p.retention), p.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune task events: %w", err)
+	}
+	return deleted, nil
+}
\ No newline at end of file
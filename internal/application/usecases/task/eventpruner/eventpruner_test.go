@@ -0,0 +1,52 @@
+package eventpruner
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneExpired_DeletesBeforeCutoff(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskeventrepo.MockTaskEventRepository)
+
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+	mockRepo.On("DeleteOlderThan", ctx, now.Add(-72*time.Hour), 500).Return(42, nil)
+
+	pruner := NewPruner(mockRepo, 72*time.Hour, 500)
+	pruner.now = func() time.Time { return now }
+
+	deleted, err := pruner.PruneExpired(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 42, deleted)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPruneExpired_ZeroRetentionKeepsEvents(t *testing.T) {
+	mockRepo := new(taskeventrepo.MockTaskEventRepository)
+
+	deleted, err := NewPruner(mockRepo, 0, 500).PruneExpired(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	mockRepo.AssertNotCalled(t, "DeleteOlderThan")
+}
+
+func TestPruneExpired_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskeventrepo.MockTaskEventRepository)
+
+	dbErr := errors.New("db down")
+	mockRepo.On("DeleteOlderThan", ctx, mock.Anything, 500).Return(0, dbErr)
+
+	_, err := NewPruner(mockRepo, time.Hour, 500).PruneExpired(ctx)
+
+	assert.ErrorIs(t, err, dbErr)
+}
\ No newline at end of file
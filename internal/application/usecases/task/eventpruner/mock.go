@@ -0,0 +1,16 @@
+package eventpruner
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockPruner struct {
+	mock.Mock
+}
+
+func (m *MockPruner) PruneExpired(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
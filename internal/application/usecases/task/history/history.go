@@ -0,0 +1,60 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// History reads the audit log of a task
+type History struct {
+	taskEventRepo  taskeventrepo.TaskEventRepository
+	taskRepo       taskrepo.TaskRepository
+	failedTaskRepo failedtaskrepo.FailedTaskRepository
+}
+
+func NewHistory(
+	taskEventRepo  taskeventrepo.TaskEventRepository,
+	taskRepo       taskrepo.TaskRepository,
+	failedTaskRepo failedtaskrepo.FailedTaskRepository,
+) *History {
+	return &History{
+		taskEventRepo:  taskEventRepo,
+		taskRepo:       taskRepo,
+		failedTaskRepo: failedTaskRepo,
+	}
+}
+
+// GetHistory returns the events of a task, oldest first. A task whose events
+// were pruned by retention has an empty history; domain.ErrTaskNotFound is
+// returned only when neither events nor the task itself exist.
+func (h *History) GetHistory(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskEvent, error) {
+	events, err := h.taskEventRepo.ListByTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of task %s: %w", taskID, err)
+	}
+	if len(events) > 0 {
+		return events, nil
+	}
+
+	if err := h.ensureExists(ctx, taskID); err != nil {
+		return nil, fmt.Errorf("failed to get history of task %s: %w", taskID, err)
+	}
+	return events, nil
+}
+
+// ensureExists looks the task up in the queue and then in the dead-letter queue
+func (h *History) ensureExists(ctx context.Context, taskID uuid.UUID) error {
+	_, err := h.taskRepo.Get(ctx, taskID)
+	if !errors.Is(err, domain.ErrTaskNotFound) {
+		return err
+	}
+	_, err = h.failedTaskRepo.Get(ctx, taskID)
+	return err
+}
\ No newline at end of file
@@ -0,0 +1,84 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHistory_ReturnsEvents(t *testing.T) {
+	ctx := context.Background()
+	mockEvents := new(taskeventrepo.MockTaskEventRepository)
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	taskID := uuid.New()
+	events := []*domain.TaskEvent{
+		{ID: 1, TaskID: taskID, Type: domain.EventCreated},
+		{ID: 2, TaskID: taskID, Type: domain.EventAcquired, Attempt: 1, Owner: "worker-1"},
+	}
+	mockEvents.On("ListByTask", ctx, taskID).Return(events, nil)
+
+	result, err := NewHistory(mockEvents, mockRepo, mockFailedRepo).GetHistory(ctx, taskID)
+
+	require.NoError(t, err)
+	assert.Equal(t, events, result)
+	// Events prove the task existed, no lookup needed
+	mockRepo.AssertNotCalled(t, "Get")
+	mockFailedRepo.AssertNotCalled(t, "Get")
+}
+
+func TestGetHistory_PrunedEventsOfExistingTask(t *testing.T) {
+	ctx := context.Background()
+	mockEvents := new(taskeventrepo.MockTaskEventRepository)
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	taskID := uuid.New()
+	mockEvents.On("ListByTask", ctx, taskID).Return([]*domain.TaskEvent{}, nil)
+	mockRepo.On("Get", ctx, taskID).Return(nil, domain.ErrTaskNotFound)
+	mockFailedRepo.On("Get", ctx, taskID).Return(&domain.Task{ID: taskID}, nil)
+
+	result, err := NewHistory(mockEvents, mockRepo, mockFailedRepo).GetHistory(ctx, taskID)
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	mockFailedRepo.AssertExpectations(t)
+}
+
+func TestGetHistory_UnknownTask(t *testing.T) {
+	ctx := context.Background()
+	mockEvents := new(taskeventrepo.MockTaskEventRepository)
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	taskID := uuid.New()
+	mockEvents.On("ListByTask", ctx, taskID).Return([]*domain.TaskEvent{}, nil)
+	mockRepo.On("Get", ctx, taskID).Return(nil, domain.ErrTaskNotFound)
+	mockFailedRepo.On("Get", ctx, taskID).Return(nil, domain.ErrTaskNotFound)
+
+	_, err := NewHistory(mockEvents, mockRepo, mockFailedRepo).GetHistory(ctx, taskID)
+
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
+
+func TestGetHistory_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	mockEvents := new(taskeventrepo.MockTaskEventRepository)
+
+	taskID := uuid.New()
+	dbErr := errors.New("db down")
+	mockEvents.On("ListByTask", ctx, taskID).Return([]*domain.TaskEvent(nil), dbErr)
+
+	_, err := NewHistory(mockEvents, nil, nil).GetHistory(ctx, taskID)
+
+	assert.ErrorIs(t, err, dbErr)
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+package history
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockHistory struct {
+	mock.Mock
+}
+
+func (m *MockHistory) GetHistory(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskEvent, error) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]*domain.TaskEvent), args.Error(1)
+}
\ No newline at end of file
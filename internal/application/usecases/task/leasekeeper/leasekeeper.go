@@ -0,0 +1,68 @@
+package leasekeeper
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// Keeper keeps the leases of acquired tasks alive until their outcome is stored,
+// so a task waiting for a worker is not reclaimed and run twice
+type Keeper struct {
+	taskRepo taskrepo.TaskRepository
+	lease    domain.Lease
+}
+
+func NewKeeper(taskRepo taskrepo.TaskRepository, lease domain.Lease) *Keeper {
+	return &Keeper{
+		taskRepo: taskRepo,
+		lease:    lease,
+	}
+}
+
+// Hold extends the lease of an acquired task every third of its duration until
+// the returned stop function is called. The returned context is cancelled with
+// domain.ErrLeaseLost when the lease is lost.
+func (k *Keeper) Hold(ctx context.Context, task *domain.Task) (context.Context, func()) {
+	leaseCtx, cancel := context.WithCancelCause(ctx)
+
+	interval := k.lease.Duration / 3
+	if interval <= 0 {
+		return leaseCtx, func() { cancel(nil) }
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-leaseCtx.Done():
+				return
+			case <-ticker.C:
+				err := k.taskRepo.ExtendLease(leaseCtx, task.ID, k.lease)
+				if errors.Is(err, domain.ErrLeaseLost) {
+					cancel(err)
+					return
+				}
+				// Other errors are transient: the next tick retries before the lease runs out
+			}
+		}
+	}()
+
+	return leaseCtx, func() {
+		close(done)
+		wg.Wait()
+		cancel(nil)
+	}
+}
\ No newline at end of file
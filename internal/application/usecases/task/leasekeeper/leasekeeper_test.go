@@ -0,0 +1,74 @@
+package leasekeeper
+
+import (
+	"context"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var testLease = domain.Lease{Owner: "test-worker", Duration: 30 * time.Millisecond}
+
+func TestHold_ExtendsLeaseUntilStopped(t *testing.T) {
+	mockRepo := new(taskrepo.MockTaskRepository)
+	task := &domain.Task{ID: uuid.New()}
+	extended := make(chan struct{}, 10)
+	mockRepo.On("ExtendLease", mock.Anything, task.ID, testLease).
+		Run(func(mock.Arguments) { extended <- struct{}{} }).
+		Return(nil)
+
+	ctx, stop := NewKeeper(mockRepo, testLease).Hold(context.Background(), task)
+
+	select {
+	case <-extended:
+	case <-time.After(time.Second):
+		t.Fatal("lease was not extended")
+	}
+	require.NoError(t, ctx.Err())
+	stop()
+
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+	assert.NotErrorIs(t, context.Cause(ctx), domain.ErrLeaseLost)
+}
+
+func TestHold_CancelsContextWhenLeaseLost(t *testing.T) {
+	mockRepo := new(taskrepo.MockTaskRepository)
+	task := &domain.Task{ID: uuid.New()}
+	mockRepo.On("ExtendLease", mock.Anything, task.ID, testLease).Return(domain.ErrLeaseLost)
+
+	ctx, stop := NewKeeper(mockRepo, testLease).Hold(context.Background(), task)
+	defer stop()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("context was not cancelled")
+	}
+	assert.ErrorIs(t, context.Cause(ctx), domain.ErrLeaseLost)
+}
+
+func TestHold_TransientErrorKeepsHolding(t *testing.T) {
+	mockRepo := new(taskrepo.MockTaskRepository)
+	task := &domain.Task{ID: uuid.New()}
+	extended := make(chan struct{}, 10)
+	mockRepo.On("ExtendLease", mock.Anything, task.ID, testLease).Return(assert.AnError).Once()
+	mockRepo.On("ExtendLease", mock.Anything, task.ID, testLease).
+		Run(func(mock.Arguments) { extended <- struct{}{} }).
+		Return(nil)
+
+	ctx, stop := NewKeeper(mockRepo, testLease).Hold(context.Background(), task)
+	defer stop()
+
+	select {
+	case <-extended:
+	case <-time.After(time.Second):
+		t.Fatal("lease was not extended after a transient error")
+	}
+	assert.NoError(t, ctx.Err())
+}
\ No newline at end of file
@@ -0,0 +1,50 @@
+package lister
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/usecases/task/cursor"
+	"task-processor/internal/domain"
+)
+
+// Lister pages through the task queue with keyset cursors
+type Lister struct {
+	taskRepo taskrepo.TaskRepository
+}
+
+func NewLister(taskRepo taskrepo.TaskRepository) *Lister {
+	return &Lister{taskRepo: taskRepo}
+}
+
+// ListTasks returns one page of tasks matching the request.
+// A malformed cursor is reported as domain.ErrInvalidCursor.
+func (l *Lister) ListTasks(ctx context.Context, request *tasksprocessor.ListTasksRequest) (*tasksprocessor.TaskPage, error) {
+	filter := domain.TaskFilter{
+		Statuses:      request.Statuses,
+		Type:          request.Type,
+		CreatedFrom:   request.CreatedFrom,
+		CreatedTo:     request.CreatedTo,
+		ErrorContains: request.ErrorContains,
+		// One extra row tells whether another page follows
+		Limit:         request.Limit + 1,
+	}
+
+	if request.Cursor != "" {
+		after, err := cursor.Decode(request.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.After = after
+	}
+
+	tasks, err := l.taskRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	page := &tasksprocessor.TaskPage{}
+	page.Tasks, page.NextCursor = cursor.Paginate(tasks, request.Limit)
+	return page, nil
+}
\ No newline at end of file
@@ -0,0 +1,113 @@
+package lister
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/usecases/task/cursor"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newTasks builds count tasks created one second apart, newest first
+func newTasks(count int) []*domain.Task {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	tasks := make([]*domain.Task, count)
+	for i := range tasks {
+		tasks[i] = &domain.Task{ID: uuid.New(), CreatedAt: start.Add(-time.Duration(i) * time.Second)}
+	}
+	return tasks
+}
+
+func TestListTasks_LastPage(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	tasks := newTasks(2)
+	mockRepo.On("List", ctx, domain.TaskFilter{
+		Statuses: []domain.TaskStatus{domain.StatusFailed},
+		Type:     "email",
+		Limit:    3,
+	}).Return(tasks, nil)
+
+	page, err := NewLister(mockRepo).ListTasks(ctx, &tasksprocessor.ListTasksRequest{
+		Statuses: []domain.TaskStatus{domain.StatusFailed},
+		Type:     "email",
+		Limit:    2,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, tasks, page.Tasks)
+	assert.Empty(t, page.NextCursor)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListTasks_NextPageCursor(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	tasks := newTasks(3)
+	mockRepo.On("List", ctx, mock.MatchedBy(func(f domain.TaskFilter) bool {
+		return f.Limit == 3 && f.After == nil
+	})).Return(tasks, nil)
+
+	page, err := NewLister(mockRepo).ListTasks(ctx, &tasksprocessor.ListTasksRequest{Limit: 2})
+
+	require.NoError(t, err)
+	assert.Len(t, page.Tasks, 2)
+	require.NotEmpty(t, page.NextCursor)
+
+	next, err := cursor.Decode(page.NextCursor)
+	require.NoError(t, err)
+	assert.Equal(t, tasks[1].ID, next.ID)
+	assert.True(t, tasks[1].CreatedAt.Equal(next.CreatedAt))
+}
+
+func TestListTasks_PassesCursor(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	after := domain.TaskCursor{CreatedAt: time.Now().UTC(), ID: uuid.New()}
+	mockRepo.On("List", ctx, mock.MatchedBy(func(f domain.TaskFilter) bool {
+		return f.After != nil && f.After.ID == after.ID && f.After.CreatedAt.Equal(after.CreatedAt)
+	})).Return([]*domain.Task{}, nil)
+
+	page, err := NewLister(mockRepo).ListTasks(ctx, &tasksprocessor.ListTasksRequest{
+		Cursor: cursor.Encode(after),
+		Limit:  10,
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, page.Tasks)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListTasks_InvalidCursor(t *testing.T) {
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	for _, cursor := range []string{"%%%", "bm8tc2VwYXJhdG9y", "eHx5"} {
+		_, err := NewLister(mockRepo).ListTasks(context.Background(), &tasksprocessor.ListTasksRequest{
+			Cursor: cursor,
+			Limit:  10,
+		})
+		assert.ErrorIs(t, err, domain.ErrInvalidCursor, cursor)
+	}
+	mockRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything)
+}
+
+func TestListTasks_RepoError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockRepo.On("List", ctx, mock.Anything).Return([]*domain.Task(nil), errors.New("db error"))
+
+	_, err := NewLister(mockRepo).ListTasks(ctx, &tasksprocessor.ListTasksRequest{Limit: 10})
+
+	assert.Error(t, err)
+}
\ No newline at end of file
@@ -0,0 +1,21 @@
+package lister
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLister struct {
+	mock.Mock
+}
+
+func (m *MockLister) ListTasks(ctx context.Context, request *tasksprocessor.ListTasksRequest) (*tasksprocessor.TaskPage, error) {
+	args := m.Called(ctx, request)
+	var page *tasksprocessor.TaskPage
+	if p := args.Get(0); p != nil {
+		page = p.(*tasksprocessor.TaskPage)
+	}
+	return page, args.Error(1)
+}
\ No newline at end of file
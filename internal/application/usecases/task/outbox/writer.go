@@ -0,0 +1,78 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Message is the JSON document published for a lifecycle event
+type Message struct {
+	Event        domain.TaskEventType `json:"event"`
+	TaskID       uuid.UUID            `json:"task_id"`
+	Type         string               `json:"type"`
+	Status       domain.TaskStatus    `json:"status"`
+	Priority     int                  `json:"priority"`
+	Attempts     int                  `json:"attempts"`
+	ErrorMessage string               `json:"error_message,omitempty"`
+	RunAt        *time.Time           `json:"run_at,omitempty"`
+	OccurredAt   time.Time            `json:"occurred_at"`
+}
+
+// Writer records lifecycle events in the outbox. Messages are written by the
+// caller's transaction, so an event is published exactly when the state change
+// it describes was committed.
+type Writer struct {
+	repo    outboxrepo.OutboxRepository
+	enabled bool
+	now     func() time.Time
+}
+
+func NewWriter(repo outboxrepo.OutboxRepository, enabled bool) *Writer {
+	return &Writer{
+		repo:    repo,
+		enabled: enabled,
+		now:     time.Now,
+	}
+}
+
+// Record writes one message per task describing event
+func (w *Writer) Record(ctx context.Context, event domain.TaskEventType, tasks ...*domain.Task) error {
+	if !w.enabled || len(tasks) == 0 {
+		return nil
+	}
+
+	occurredAt := w.now().UTC()
+	messages := make([]*domain.OutboxMessage, len(tasks))
+	for i, task := range tasks {
+		payload, err := json.Marshal(Message{
+			Event:        event,
+			TaskID:       task.ID,
+			Type:         task.Type,
+			Status:       task.Status,
+			Priority:     task.Priority,
+			Attempts:     task.Attempts,
+			ErrorMessage: task.ErrorMessage,
+			RunAt:        task.RunAt,
+			OccurredAt:   occurredAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to encode outbox message: %w", err)
+		}
+		messages[i] = &domain.OutboxMessage{
+			TaskID:  task.ID,
+			Event:   event,
+			Payload: payload,
+		}
+	}
+
+	if err := w.repo.Append(ctx, messages); err != nil {
+		return fmt.Errorf("failed to write outbox: %w", err)
+	}
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,74 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord_OneMessagePerTask(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(outboxrepo.MockOutboxRepository)
+
+	now := time.Date(2025, 5, 1, 8, 30, 0, 0, time.UTC)
+	first := &domain.Task{ID: uuid.New(), Type: "email", Status: domain.StatusNew, Priority: 5}
+	second := &domain.Task{ID: uuid.New(), Type: "report", Status: domain.StatusNew}
+
+	var written []*domain.OutboxMessage
+	mockRepo.On("Append", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		written = args.Get(1).([]*domain.OutboxMessage)
+	}).Return(nil)
+
+	writer := NewWriter(mockRepo, true)
+	writer.now = func() time.Time { return now }
+
+	require.NoError(t, writer.Record(ctx, domain.EventCreated, first, second))
+
+	require.Len(t, written, 2)
+	assert.Equal(t, first.ID, written[0].TaskID)
+	assert.Equal(t, second.ID, written[1].TaskID)
+	assert.Equal(t, domain.EventCreated, written[0].Event)
+
+	var message Message
+	require.NoError(t, json.Unmarshal(written[0].Payload, &message))
+	assert.Equal(t, Message{
+		Event:      domain.EventCreated,
+		TaskID:     first.ID,
+		Type:       "email",
+		Status:     domain.StatusNew,
+		Priority:   5,
+		OccurredAt: now,
+	}, message)
+}
+
+func TestRecord_DisabledOrEmpty(t *testing.T) {
+	mockRepo := new(outboxrepo.MockOutboxRepository)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email"}
+
+	require.NoError(t, NewWriter(mockRepo, false).Record(context.Background(), domain.EventProcessed, task))
+	require.NoError(t, NewWriter(mockRepo, true).Record(context.Background(), domain.EventProcessed))
+
+	mockRepo.AssertNotCalled(t, "Append", mock.Anything, mock.Anything)
+}
+
+func TestRecord_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(outboxrepo.MockOutboxRepository)
+
+	dbErr := errors.New("db down")
+	mockRepo.On("Append", ctx, mock.Anything).Return(dbErr)
+
+	err := NewWriter(mockRepo, true).Record(ctx, domain.EventFailed, &domain.Task{ID: uuid.New()})
+
+	assert.ErrorIs(t, err, dbErr)
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+package outboxrelay
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRelay struct {
+	mock.Mock
+}
+
+func (m *MockRelay) PublishPending(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
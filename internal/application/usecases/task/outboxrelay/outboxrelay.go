@@ -0,0 +1,137 @@
+package outboxrelay
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/outboxsink"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// LockKey identifies the cluster-wide lock held while claiming outbox messages
+const LockKey int64 = 0x6f7574626f78
+
+// Relay publishes outbox messages to the sink. A batch is claimed for a lease
+// in a short transaction, published without holding one, and marked published
+// in a second transaction. Only one claim is held at a time and messages go out
+// in the order they were written, so the events of a task are published in order.
+// A crash or a lease running out before the mark publishes the batch again.
+type Relay struct {
+	repo       outboxrepo.OutboxRepository
+	sink       outboxsink.Sink
+	txManager  txmanager.TxManager
+	locker     locker.Locker
+	batchSize  int
+	claimLease time.Duration
+	retention  time.Duration
+	now        func() time.Time
+}
+
+func NewRelay(
+	repo       outboxrepo.OutboxRepository,
+	sink       outboxsink.Sink,
+	txManager  txmanager.TxManager,
+	locker     locker.Locker,
+	batchSize  int,
+	claimLease time.Duration,
+	retention  time.Duration,
+) *Relay {
+	return &Relay{
+		repo:       repo,
+		sink:       sink,
+		txManager:  txManager,
+		locker:     locker,
+		batchSize:  batchSize,
+		claimLease: claimLease,
+		retention:  retention,
+		now:        time.Now,
+	}
+}
+
+// PublishPending publishes up to one batch of messages and reports how many were published
+func (r *Relay) PublishPending(ctx context.Context) (int, error) {
+	messages, err := r.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	var publishErr error
+	published := make([]int64, 0, len(messages))
+	for _, message := range messages {
+		// Publishing past a failure would overtake the failed message
+		if err := r.sink.Publish(ctx, message); err != nil {
+			publishErr = fmt.Errorf("failed to publish outbox message %d: %w", message.ID, err)
+			break
+		}
+		published = append(published, message.ID)
+	}
+
+	unpublished := make([]int64, 0, len(messages)-len(published))
+	for _, message := range messages[len(published):] {
+		unpublished = append(unpublished, message.ID)
+	}
+
+	err = r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if len(published) > 0 {
+			if err := r.repo.MarkPublished(ctx, published); err != nil {
+				return fmt.Errorf("failed to mark outbox messages published: %w", err)
+			}
+		}
+		// The next run retries them instead of waiting for the lease to run out
+		if len(unpublished) > 0 {
+			if err := r.repo.Release(ctx, unpublished); err != nil {
+				return fmt.Errorf("failed to release outbox messages: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// The claim runs out and the whole batch is published again
+		return 0, err
+	}
+
+	return len(published), publishErr
+}
+
+// claim reserves the next batch. The lock serializes claims across instances,
+// it is released with the transaction once the batch is reserved.
+func (r *Relay) claim(ctx context.Context) ([]*domain.OutboxMessage, error) {
+	var messages []*domain.OutboxMessage
+
+	err := r.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		acquired, err := r.locker.TryLock(ctx, LockKey)
+		if err != nil {
+			return fmt.Errorf("failed to take outbox lock: %w", err)
+		}
+		if !acquired {
+			// Another instance is claiming right now
+			return nil
+		}
+
+		messages, err = r.repo.Claim(ctx, r.batchSize, r.claimLease)
+		if err != nil {
+			return fmt.Errorf("failed to claim outbox messages: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+// PrunePublished deletes up to one batch of messages published longer than
+// the retention period ago and reports how many were deleted
+func (r *Relay) PrunePublished(ctx context.Context) (int, error) {
+	deleted, err := r.repo.DeletePublishedBefore(ctx, r.now().Add(-r.retention), r.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune outbox messages: %w", err)
+	}
+	return deleted, nil
+}
\ No newline at end of file
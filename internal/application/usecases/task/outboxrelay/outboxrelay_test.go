@@ -0,0 +1,188 @@
+package outboxrelay
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/outboxsink"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBatchSize  = 10
+	testClaimLease = 30 * time.Second
+	testRetention  = 24 * time.Hour
+)
+
+type mocks struct {
+	repo   *outboxrepo.MockOutboxRepository
+	sink   *outboxsink.MockSink
+	tx     *txmanager.MockTxManager
+	locker *locker.MockLocker
+}
+
+func newTestRelay() (*Relay, *mocks) {
+	m := &mocks{
+		repo:   new(outboxrepo.MockOutboxRepository),
+		sink:   new(outboxsink.MockSink),
+		tx:     new(txmanager.MockTxManager),
+		locker: new(locker.MockLocker),
+	}
+	m.tx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+
+	return NewRelay(m.repo, m.sink, m.tx, m.locker, testBatchSize, testClaimLease, testRetention), m
+}
+
+func newMessage(id int64) *domain.OutboxMessage {
+	return &domain.OutboxMessage{ID: id, TaskID: uuid.New(), Event: domain.EventCreated, Payload: []byte(`{}`)}
+}
+
+func TestPublishPending_PublishesInOrder(t *testing.T) {
+	ctx := context.Background()
+	r, m := newTestRelay()
+
+	messages := []*domain.OutboxMessage{newMessage(1), newMessage(2)}
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("Claim", ctx, testBatchSize, testClaimLease).Return(messages, nil)
+	m.sink.On("Publish", ctx, mock.Anything).Return(nil)
+	m.repo.On("MarkPublished", ctx, []int64{1, 2}).Return(nil)
+
+	published, err := r.PublishPending(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, published)
+	assert.Same(t, messages[0], m.sink.Calls[0].Arguments.Get(1))
+	assert.Same(t, messages[1], m.sink.Calls[1].Arguments.Get(1))
+	m.repo.AssertExpectations(t)
+	m.repo.AssertNotCalled(t, "Release", mock.Anything, mock.Anything)
+}
+
+func TestPublishPending_PublishesBetweenTransactions(t *testing.T) {
+	ctx := context.Background()
+	m := &mocks{
+		repo:   new(outboxrepo.MockOutboxRepository),
+		sink:   new(outboxsink.MockSink),
+		tx:     new(txmanager.MockTxManager),
+		locker: new(locker.MockLocker),
+	}
+	r := NewRelay(m.repo, m.sink, m.tx, m.locker, testBatchSize, testClaimLease, testRetention)
+
+	var calls []string
+	record := func(name string) func(mock.Arguments) {
+		return func(mock.Arguments) { calls = append(calls, name) }
+	}
+	m.tx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil).Run(record("begin"))
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("Claim", ctx, testBatchSize, testClaimLease).Return([]*domain.OutboxMessage{newMessage(1)}, nil).
+		Run(record("claim"))
+	m.sink.On("Publish", ctx, mock.Anything).Return(nil).Run(record("publish"))
+	m.repo.On("MarkPublished", ctx, []int64{1}).Return(nil).Run(record("mark"))
+
+	_, err := r.PublishPending(ctx)
+
+	require.NoError(t, err)
+	// The claim transaction has returned before publishing, the mark runs in a new one
+	assert.Equal(t, []string{"begin", "claim", "publish", "begin", "mark"}, calls)
+}
+
+func TestPublishPending_LockNotAcquired(t *testing.T) {
+	ctx := context.Background()
+	r, m := newTestRelay()
+	m.locker.On("TryLock", ctx, LockKey).Return(false, nil)
+
+	published, err := r.PublishPending(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, published)
+	m.repo.AssertNotCalled(t, "Claim", mock.Anything, mock.Anything, mock.Anything)
+	m.tx.AssertNumberOfCalls(t, "WithTransaction", 1)
+}
+
+func TestPublishPending_NothingClaimed(t *testing.T) {
+	ctx := context.Background()
+	r, m := newTestRelay()
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("Claim", ctx, testBatchSize, testClaimLease).Return([]*domain.OutboxMessage{}, nil)
+
+	published, err := r.PublishPending(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, published)
+	m.sink.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	m.repo.AssertNotCalled(t, "MarkPublished", mock.Anything, mock.Anything)
+}
+
+func TestPublishPending_StopsAtFirstFailure(t *testing.T) {
+	ctx := context.Background()
+	r, m := newTestRelay()
+
+	messages := []*domain.OutboxMessage{newMessage(1), newMessage(2), newMessage(3)}
+	sinkErr := errors.New("stream unavailable")
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("Claim", ctx, testBatchSize, testClaimLease).Return(messages, nil)
+	m.sink.On("Publish", ctx, messages[0]).Return(nil)
+	m.sink.On("Publish", ctx, messages[1]).Return(sinkErr)
+	m.repo.On("MarkPublished", ctx, []int64{1}).Return(nil)
+	m.repo.On("Release", ctx, []int64{2, 3}).Return(nil)
+
+	published, err := r.PublishPending(ctx)
+
+	assert.ErrorIs(t, err, sinkErr)
+	assert.Equal(t, 1, published)
+	m.sink.AssertNotCalled(t, "Publish", ctx, messages[2])
+	m.repo.AssertExpectations(t)
+}
+
+func TestPublishPending_MarkError(t *testing.T) {
+	ctx := context.Background()
+	r, m := newTestRelay()
+
+	dbErr := errors.New("db down")
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("Claim", ctx, testBatchSize, testClaimLease).Return([]*domain.OutboxMessage{newMessage(1)}, nil)
+	m.sink.On("Publish", ctx, mock.Anything).Return(nil)
+	m.repo.On("MarkPublished", ctx, []int64{1}).Return(dbErr)
+
+	published, err := r.PublishPending(ctx)
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Zero(t, published)
+}
+
+func TestPublishPending_ClaimError(t *testing.T) {
+	ctx := context.Background()
+	r, m := newTestRelay()
+
+	dbErr := errors.New("db down")
+	m.locker.On("TryLock", ctx, LockKey).Return(true, nil)
+	m.repo.On("Claim", ctx, testBatchSize, testClaimLease).Return([]*domain.OutboxMessage(nil), dbErr)
+
+	published, err := r.PublishPending(ctx)
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Zero(t, published)
+	m.sink.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestPrunePublished_DeletesPastRetention(t *testing.T) {
+	ctx := context.Background()
+	r, m := newTestRelay()
+	now := time.Date(2025, 10, 23, 12, 0, 0, 0, time.UTC)
+	r.now = func() time.Time { return now }
+
+	m.repo.On("DeletePublishedBefore", ctx, now.Add(-testRetention), testBatchSize).Return(7, nil)
+
+	deleted, err := r.PrunePublished(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 7, deleted)
+}
\ No newline at end of file
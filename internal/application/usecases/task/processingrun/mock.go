@@ -0,0 +1,62 @@
+package processingrun
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTracker struct {
+	mock.Mock
+}
+
+func (m *MockTracker) Start(ctx context.Context, request *tasksprocessor.ProcessTasksRequest) (*domain.ProcessingRun, error) {
+	args := m.Called(ctx, request)
+	var run *domain.ProcessingRun
+	if r := args.Get(0); r != nil {
+		run = r.(*domain.ProcessingRun)
+	}
+	return run, args.Error(1)
+}
+
+func (m *MockTracker) Acquired(ctx context.Context, runID uuid.UUID, count int) error {
+	args := m.Called(ctx, runID, count)
+	return args.Error(0)
+}
+
+func (m *MockTracker) Record(ctx context.Context, runID uuid.UUID, outcome tasksprocessor.TaskOutcomeKind) error {
+	args := m.Called(ctx, runID, outcome)
+	return args.Error(0)
+}
+
+func (m *MockTracker) Finish(ctx context.Context, runID uuid.UUID, runErr error) error {
+	args := m.Called(ctx, runID, runErr)
+	return args.Error(0)
+}
+
+func (m *MockTracker) Get(ctx context.Context, runID uuid.UUID) (*domain.ProcessingRun, error) {
+	args := m.Called(ctx, runID)
+	var run *domain.ProcessingRun
+	if r := args.Get(0); r != nil {
+		run = r.(*domain.ProcessingRun)
+	}
+	return run, args.Error(1)
+}
+
+func (m *MockTracker) KeepAlive(ctx context.Context, runID uuid.UUID) func() {
+	args := m.Called(ctx, runID)
+	return args.Get(0).(func())
+}
+
+func (m *MockTracker) FailOrphaned(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockTracker) PruneFinished(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
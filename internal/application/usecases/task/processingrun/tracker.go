@@ -0,0 +1,178 @@
+package processingrun
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/processingrunrepo"
+	"task-processor/internal/domain"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tracker persists background processing runs and their progress, so a run
+// can be polled from any instance
+type Tracker struct {
+	repo       processingrunrepo.ProcessingRunRepository
+	owner      string
+	staleAfter time.Duration
+	retention  time.Duration
+	batchSize  int
+	now        func() time.Time
+}
+
+// NewTracker creates a tracker. A run without progress for staleAfter is
+// considered orphaned by its instance and finished runs are kept for
+// retention; zero disables either.
+func NewTracker(
+	repo processingrunrepo.ProcessingRunRepository,
+	owner string,
+	staleAfter time.Duration,
+	retention time.Duration,
+	batchSize int,
+) *Tracker {
+	return &Tracker{
+		repo:       repo,
+		owner:      owner,
+		staleAfter: staleAfter,
+		retention:  retention,
+		batchSize:  batchSize,
+		now:        time.Now,
+	}
+}
+
+// Start records a new run of the request, executed by this instance
+func (t *Tracker) Start(ctx context.Context, request *tasksprocessor.ProcessTasksRequest) (*domain.ProcessingRun, error) {
+	run := &domain.ProcessingRun{
+		ID:         uuid.New(),
+		Status:     domain.RunRunning,
+		Owner:      t.owner,
+		Limit:      request.Limit,
+		MinDelayMS: request.MinDelayMS,
+		MaxDelayMS: request.MaxDelayMS,
+	}
+	if err := t.repo.Create(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to start processing run: %w", err)
+	}
+	return run, nil
+}
+
+// Acquired records how many tasks the run acquired
+func (t *Tracker) Acquired(ctx context.Context, runID uuid.UUID, count int) error {
+	if count == 0 {
+		return nil
+	}
+	return t.addProgress(ctx, runID, domain.RunCounters{Acquired: count})
+}
+
+// Record adds the outcome of a single task to the run
+func (t *Tracker) Record(ctx context.Context, runID uuid.UUID, outcome tasksprocessor.TaskOutcomeKind) error {
+	var delta domain.RunCounters
+	switch outcome {
+	case tasksprocessor.OutcomeProcessed:
+		delta = domain.RunCounters{Processed: 1, Succeeded: 1}
+	case tasksprocessor.OutcomeFailed, tasksprocessor.OutcomeDeadLettered:
+		delta = domain.RunCounters{Processed: 1, Failed: 1}
+	case tasksprocessor.OutcomeError:
+		delta = domain.RunCounters{Processed: 1, Errors: 1}
+	case tasksprocessor.OutcomeReleased:
+		delta = domain.RunCounters{Released: 1}
+	default:
+		return fmt.Errorf("unknown task outcome %q", outcome)
+	}
+	return t.addProgress(ctx, runID, delta)
+}
+
+// Finish marks the run completed, or failed when runErr is set.
+// domain.ErrRunFinished is returned wrapped when the run was already failed as orphaned.
+func (t *Tracker) Finish(ctx context.Context, runID uuid.UUID, runErr error) error {
+	status, message := domain.RunCompleted, ""
+	if runErr != nil {
+		status, message = domain.RunFailed, runErr.Error()
+	}
+	if err := t.repo.Finish(ctx, runID, status, message); err != nil {
+		return fmt.Errorf("failed to finish processing run %s: %w", runID, err)
+	}
+	return nil
+}
+
+// KeepAlive refreshes the run every third of the stale period until the
+// returned stop function is called, so a run waiting on slow tasks is not
+// taken for orphaned
+func (t *Tracker) KeepAlive(ctx context.Context, runID uuid.UUID) func() {
+	interval := t.staleAfter / 3
+	if interval <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				// A missed refresh is retried on the next tick, well before the run turns stale
+				_ = t.repo.AddProgress(ctx, runID, domain.RunCounters{})
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		wg.Wait()
+	}
+}
+
+// FailOrphaned fails running runs whose instance stopped refreshing them and
+// returns how many were failed
+func (t *Tracker) FailOrphaned(ctx context.Context) (int, error) {
+	if t.staleAfter <= 0 {
+		return 0, nil
+	}
+	failed, err := t.repo.FailStale(ctx, t.staleAfter, t.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail orphaned processing runs: %w", err)
+	}
+	return failed, nil
+}
+
+// PruneFinished deletes up to one batch of runs finished longer than the
+// retention period ago and reports how many were deleted
+func (t *Tracker) PruneFinished(ctx context.Context) (int, error) {
+	if t.retention <= 0 {
+		return 0, nil
+	}
+	deleted, err := t.repo.DeleteFinishedBefore(ctx, t.now().Add(-t.retention), t.batchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune processing runs: %w", err)
+	}
+	return deleted, nil
+}
+
+// Get returns the run. domain.ErrRunNotFound is returned wrapped when it does not exist.
+func (t *Tracker) Get(ctx context.Context, runID uuid.UUID) (*domain.ProcessingRun, error) {
+	run, err := t.repo.Get(ctx, runID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get processing run %s: %w", runID, err)
+	}
+	return run, nil
+}
+
+func (t *Tracker) addProgress(ctx context.Context, runID uuid.UUID, delta domain.RunCounters) error {
+	if err := t.repo.AddProgress(ctx, runID, delta); err != nil {
+		return fmt.Errorf("failed to update processing run %s: %w", runID, err)
+	}
+	return nil
+}
\ No newline at end of file
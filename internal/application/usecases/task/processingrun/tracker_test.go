@@ -0,0 +1,170 @@
+package processingrun
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/processingrunrepo"
+	"task-processor/internal/domain"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStart_CreatesRunningRun(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+	mockRepo.On("Create", ctx, mock.AnythingOfType("*domain.ProcessingRun")).Return(nil)
+
+	run, err := NewTracker(mockRepo, "instance-1", time.Minute, 0, 100).Start(ctx, &tasksprocessor.ProcessTasksRequest{
+		Limit:      10,
+		MinDelayMS: 5,
+		MaxDelayMS: 20,
+	})
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, run.ID)
+	assert.Equal(t, domain.RunRunning, run.Status)
+	assert.Equal(t, "instance-1", run.Owner)
+	assert.Equal(t, 10, run.Limit)
+	assert.Equal(t, 5, run.MinDelayMS)
+	assert.Equal(t, 20, run.MaxDelayMS)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRecord_CountsOutcomes(t *testing.T) {
+	ctx := context.Background()
+	runID := uuid.New()
+
+	cases := map[tasksprocessor.TaskOutcomeKind]domain.RunCounters{
+		tasksprocessor.OutcomeProcessed:    {Processed: 1, Succeeded: 1},
+		tasksprocessor.OutcomeFailed:       {Processed: 1, Failed: 1},
+		tasksprocessor.OutcomeDeadLettered: {Processed: 1, Failed: 1},
+		tasksprocessor.OutcomeError:        {Processed: 1, Errors: 1},
+		tasksprocessor.OutcomeReleased:     {Released: 1},
+	}
+
+	for outcome, delta := range cases {
+		mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+		mockRepo.On("AddProgress", ctx, runID, delta).Return(nil)
+
+		assert.NoError(t, NewTracker(mockRepo, "instance-1", time.Minute, 0, 100).Record(ctx, runID, outcome), outcome)
+		mockRepo.AssertExpectations(t)
+	}
+}
+
+func TestAcquired_SkipsEmptyRuns(t *testing.T) {
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+
+	assert.NoError(t, NewTracker(mockRepo, "instance-1", time.Minute, 0, 100).Acquired(context.Background(), uuid.New(), 0))
+	mockRepo.AssertNotCalled(t, "AddProgress", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFinish_SetsStatusFromError(t *testing.T) {
+	ctx := context.Background()
+	runID := uuid.New()
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+	mockRepo.On("Finish", ctx, runID, domain.RunCompleted, "").Return(nil).Once()
+	mockRepo.On("Finish", ctx, runID, domain.RunFailed, "acquire failed").Return(nil).Once()
+
+	tracker := NewTracker(mockRepo, "instance-1", time.Minute, 0, 100)
+
+	assert.NoError(t, tracker.Finish(ctx, runID, nil))
+	assert.NoError(t, tracker.Finish(ctx, runID, errors.New("acquire failed")))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGet_NotFound(t *testing.T) {
+	ctx := context.Background()
+	runID := uuid.New()
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+	mockRepo.On("Get", ctx, runID).Return(nil, domain.ErrRunNotFound)
+
+	_, err := NewTracker(mockRepo, "instance-1", time.Minute, 0, 100).Get(ctx, runID)
+
+	assert.ErrorIs(t, err, domain.ErrRunNotFound)
+}
+
+func TestKeepAlive_RefreshesRunUntilStopped(t *testing.T) {
+	ctx := context.Background()
+	runID := uuid.New()
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+	var refreshes atomic.Int32
+	mockRepo.On("AddProgress", mock.Anything, runID, domain.RunCounters{}).
+		Run(func(mock.Arguments) { refreshes.Add(1) }).
+		Return(nil)
+
+	stop := NewTracker(mockRepo, "instance-1", 30*time.Millisecond, 0, 100).KeepAlive(ctx, runID)
+
+	require.Eventually(t, func() bool { return refreshes.Load() > 0 }, time.Second, 5*time.Millisecond)
+	stop()
+
+	after := refreshes.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, after, refreshes.Load(), "run refreshed after stop")
+}
+
+func TestFailOrphaned_FailsStaleRuns(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+	mockRepo.On("FailStale", ctx, time.Minute, 100).Return(2, nil)
+
+	failed, err := NewTracker(mockRepo, "instance-1", time.Minute, 0, 100).FailOrphaned(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, failed)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFailOrphaned_DisabledWithoutStalePeriod(t *testing.T) {
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+
+	failed, err := NewTracker(mockRepo, "instance-1", 0, 0, 100).FailOrphaned(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, failed)
+	mockRepo.AssertNotCalled(t, "FailStale", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFinish_RunAlreadyFailedAsOrphaned(t *testing.T) {
+	ctx := context.Background()
+	runID := uuid.New()
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+	mockRepo.On("Finish", ctx, runID, domain.RunCompleted, "").Return(domain.ErrRunFinished)
+
+	err := NewTracker(mockRepo, "instance-1", time.Minute, 0, 100).Finish(ctx, runID, nil)
+
+	assert.ErrorIs(t, err, domain.ErrRunFinished)
+}
+
+func TestPruneFinished_DeletesBeforeCutoff(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+
+	now := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+	mockRepo.On("DeleteFinishedBefore", ctx, now.Add(-72*time.Hour), 100).Return(3, nil)
+
+	tracker := NewTracker(mockRepo, "instance-1", time.Minute, 72*time.Hour, 100)
+	tracker.now = func() time.Time { return now }
+
+	deleted, err := tracker.PruneFinished(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, deleted)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestPruneFinished_ZeroRetentionKeepsRuns(t *testing.T) {
+	mockRepo := new(processingrunrepo.MockProcessingRunRepository)
+
+	deleted, err := NewTracker(mockRepo, "instance-1", time.Minute, 0, 100).PruneFinished(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+	mockRepo.AssertNotCalled(t, "DeleteFinishedBefore", mock.Anything, mock.Anything, mock.Anything)
+}
\ No newline at end of file
@@ -0,0 +1,27 @@
+package reader
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReader struct {
+	mock.Mock
+}
+
+func (m *MockReader) GetTask(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	args := m.Called(ctx, taskID)
+	var task *domain.Task
+	if t := args.Get(0); t != nil {
+		task = t.(*domain.Task)
+	}
+	return task, args.Error(1)
+}
+
+func (m *MockReader) GetAttempts(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskAttempt, error) {
+	args := m.Called(ctx, taskID)
+	return args.Get(0).([]*domain.TaskAttempt), args.Error(1)
+}
\ No newline at end of file
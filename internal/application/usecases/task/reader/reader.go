@@ -0,0 +1,59 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Reader looks tasks up wherever they currently live: the queue or the dead-letter queue
+type Reader struct {
+	taskRepo       taskrepo.TaskRepository
+	failedTaskRepo failedtaskrepo.FailedTaskRepository
+	attemptRepo    taskattemptrepo.TaskAttemptRepository
+}
+
+func NewReader(
+	taskRepo       taskrepo.TaskRepository,
+	failedTaskRepo failedtaskrepo.FailedTaskRepository,
+	attemptRepo    taskattemptrepo.TaskAttemptRepository,
+) *Reader {
+	return &Reader{
+		taskRepo:       taskRepo,
+		failedTaskRepo: failedTaskRepo,
+		attemptRepo:    attemptRepo,
+	}
+}
+
+// GetTask returns the task from tasks, falling back to failed_tasks.
+// domain.ErrTaskNotFound is returned wrapped when it is in neither.
+func (r *Reader) GetTask(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	task, err := r.taskRepo.Get(ctx, taskID)
+	if err == nil {
+		return task, nil
+	}
+	if !errors.Is(err, domain.ErrTaskNotFound) {
+		return nil, fmt.Errorf("failed to get task %s: %w", taskID, err)
+	}
+
+	task, err = r.failedTaskRepo.Get(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task %s: %w", taskID, err)
+	}
+	return task, nil
+}
+
+// GetAttempts returns the recorded attempts of a task, oldest first
+func (r *Reader) GetAttempts(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskAttempt, error) {
+	attempts, err := r.attemptRepo.ListByTask(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attempts of task %s: %w", taskID, err)
+	}
+	return attempts, nil
+}
\ No newline at end of file
@@ -0,0 +1,93 @@
+package reader
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGetTask_FromQueue(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	task := &domain.Task{ID: uuid.New(), Status: domain.StatusProcessed}
+	mockRepo.On("Get", ctx, task.ID).Return(task, nil)
+
+	got, err := NewReader(mockRepo, mockFailedRepo, nil).GetTask(ctx, task.ID)
+
+	assert.NoError(t, err)
+	assert.Same(t, task, got)
+	mockFailedRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestGetTask_FallsBackToDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	movedAt := time.Now()
+	task := &domain.Task{ID: uuid.New(), Status: domain.StatusFailed, MovedAt: &movedAt}
+	mockRepo.On("Get", ctx, task.ID).Return(nil, domain.ErrTaskNotFound)
+	mockFailedRepo.On("Get", ctx, task.ID).Return(task, nil)
+
+	got, err := NewReader(mockRepo, mockFailedRepo, nil).GetTask(ctx, task.ID)
+
+	assert.NoError(t, err)
+	assert.Same(t, task, got)
+}
+
+func TestGetTask_NotFound(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	id := uuid.New()
+	mockRepo.On("Get", ctx, id).Return(nil, domain.ErrTaskNotFound)
+	mockFailedRepo.On("Get", ctx, id).Return(nil, domain.ErrTaskNotFound)
+
+	_, err := NewReader(mockRepo, mockFailedRepo, nil).GetTask(ctx, id)
+
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
+
+func TestGetTask_QueueError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	id := uuid.New()
+	mockRepo.On("Get", ctx, id).Return(nil, errors.New("db error"))
+
+	_, err := NewReader(mockRepo, mockFailedRepo, nil).GetTask(ctx, id)
+
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, domain.ErrTaskNotFound)
+	mockFailedRepo.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+}
+
+func TestGetAttempts(t *testing.T) {
+	ctx := context.Background()
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+
+	id := uuid.New()
+	attempts := []*domain.TaskAttempt{
+		{ID: 1, TaskID: id, Number: 1, Outcome: domain.AttemptFailed, ErrorMessage: "timeout"},
+		{ID: 2, TaskID: id, Number: 2, Outcome: domain.AttemptSucceeded},
+	}
+	mockAttempts.On("ListByTask", ctx, id).Return(attempts, nil)
+
+	got, err := NewReader(nil, nil, mockAttempts).GetAttempts(ctx, id)
+
+	assert.NoError(t, err)
+	assert.Equal(t, attempts, got)
+}
\ No newline at end of file
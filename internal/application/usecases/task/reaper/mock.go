@@ -0,0 +1,16 @@
+package reaper
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockReaper struct {
+	mock.Mock
+}
+
+func (m *MockReaper) ReleaseExpired(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
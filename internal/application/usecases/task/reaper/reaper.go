@@ -0,0 +1,37 @@
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/usecases/task/backoff"
+)
+
+// Reaper returns tasks whose lease expired (crashed or stalled instance) back to the queue
+type Reaper struct {
+	taskRepo     taskrepo.TaskRepository
+	retryBackoff *backoff.Policy
+	batchSize    int
+}
+
+func NewReaper(
+	taskRepo     taskrepo.TaskRepository,
+	retryBackoff *backoff.Policy,
+	batchSize    int,
+) *Reaper {
+	return &Reaper{
+		taskRepo:     taskRepo,
+		retryBackoff: retryBackoff,
+		batchSize:    batchSize,
+	}
+}
+
+// ReleaseExpired releases up to one batch of expired leases and reports how many were released.
+// The lost run counts as a failed attempt, so the task is retried after the usual backoff.
+func (r *Reaper) ReleaseExpired(ctx context.Context) (int, error) {
+	released, err := r.taskRepo.ReleaseExpiredLeases(ctx, r.batchSize, r.retryBackoff.Delay)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release expired leases: %w", err)
+	}
+	return released, nil
+}
\ No newline at end of file
@@ -0,0 +1,63 @@
+package reaper
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/usecases/task/backoff"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testBackoff = backoff.NewPolicy(backoff.Config{
+	Base:       time.Second,
+	Multiplier: 2,
+	Cap:        time.Minute,
+	Jitter:     backoff.JitterNone,
+}, nil)
+
+func TestReleaseExpired_Success(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockRepo.On("ReleaseExpiredLeases", ctx, 100, mock.Anything).Return(4, nil)
+
+	r := NewReaper(mockRepo, testBackoff, 100)
+	released, err := r.ReleaseExpired(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, released)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReleaseExpired_PostponesRetryByBackoff(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	var retryAfter func(attempts int) time.Duration
+	mockRepo.On("ReleaseExpiredLeases", ctx, 100, mock.Anything).Run(func(args mock.Arguments) {
+		retryAfter = args.Get(2).(func(attempts int) time.Duration)
+	}).Return(1, nil)
+
+	r := NewReaper(mockRepo, testBackoff, 100)
+	_, err := r.ReleaseExpired(ctx)
+
+	assert.NoError(t, err)
+	// The lost run is treated like a failed attempt
+	assert.Equal(t, time.Second, retryAfter(1))
+	assert.Equal(t, 4*time.Second, retryAfter(3))
+}
+
+func TestReleaseExpired_Error(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockRepo.On("ReleaseExpiredLeases", ctx, 100, mock.Anything).Return(0, errors.New("db error"))
+
+	r := NewReaper(mockRepo, testBackoff, 100)
+	released, err := r.ReleaseExpired(ctx)
+
+	assert.Error(t, err)
+	assert.Zero(t, released)
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+package rescheduler
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRescheduler struct {
+	mock.Mock
+}
+
+func (m *MockRescheduler) Reschedule(ctx context.Context, taskID uuid.UUID, request *tasksprocessor.RescheduleTaskRequest) (time.Time, error) {
+	args := m.Called(ctx, taskID, request)
+	return args.Get(0).(time.Time), args.Error(1)
+}
\ No newline at end of file
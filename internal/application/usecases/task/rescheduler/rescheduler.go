@@ -0,0 +1,39 @@
+package rescheduler
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rescheduler changes the due time of tasks that have not started processing
+type Rescheduler struct {
+	taskRepo taskrepo.TaskRepository
+}
+
+func NewRescheduler(taskRepo taskrepo.TaskRepository) *Rescheduler {
+	return &Rescheduler{taskRepo: taskRepo}
+}
+
+// Reschedule moves the task to the requested due time and returns it.
+// domain.ErrTaskNotFound and domain.ErrTaskNotPending are passed through wrapped.
+func (r *Rescheduler) Reschedule(
+	ctx context.Context,
+	taskID uuid.UUID,
+	request *tasksprocessor.RescheduleTaskRequest,
+) (time.Time, error) {
+	runAt := time.Now().Add(request.Delay)
+	if request.RunAt != nil {
+		runAt = *request.RunAt
+	}
+
+	if err := r.taskRepo.Reschedule(ctx, taskID, runAt); err != nil {
+		return time.Time{}, fmt.Errorf("failed to reschedule task %s: %w", taskID, err)
+	}
+
+	return runAt, nil
+}
\ No newline at end of file
@@ -0,0 +1,60 @@
+package rescheduler
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReschedule_RunAt(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	taskID := uuid.New()
+	runAt := time.Date(2030, 1, 1, 2, 0, 0, 0, time.UTC)
+	mockRepo.On("Reschedule", ctx, taskID, runAt).Return(nil)
+
+	r := NewRescheduler(mockRepo)
+	got, err := r.Reschedule(ctx, taskID, &tasksprocessor.RescheduleTaskRequest{RunAt: &runAt})
+
+	assert.NoError(t, err)
+	assert.Equal(t, runAt, got)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReschedule_Delay(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	taskID := uuid.New()
+	before := time.Now()
+	mockRepo.On("Reschedule", ctx, taskID, mock.MatchedBy(func(runAt time.Time) bool {
+		return !runAt.Before(before.Add(10*time.Minute)) && runAt.Before(time.Now().Add(11*time.Minute))
+	})).Return(nil)
+
+	r := NewRescheduler(mockRepo)
+	_, err := r.Reschedule(ctx, taskID, &tasksprocessor.RescheduleTaskRequest{Delay: 10 * time.Minute})
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReschedule_NotPending(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+
+	taskID := uuid.New()
+	mockRepo.On("Reschedule", ctx, taskID, mock.Anything).Return(domain.ErrTaskNotPending)
+
+	r := NewRescheduler(mockRepo)
+	_, err := r.Reschedule(ctx, taskID, &tasksprocessor.RescheduleTaskRequest{})
+
+	assert.ErrorIs(t, err, domain.ErrTaskNotPending)
+}
\ No newline at end of file
@@ -2,85 +2,278 @@ package singleprocessor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
 	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
 	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/ports/outbound/taskhandler"
 	"task-processor/internal/application/ports/inbound/random"
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/usecases/task/backoff"
+	"task-processor/internal/application/usecases/task/outbox"
+	"task-processor/internal/application/usecases/task/stream"
+	"task-processor/internal/application/usecases/task/webhook"
 	"task-processor/internal/domain"
 	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer is a no-op until the application installs a tracer provider
+var tracer = otel.Tracer("task-processor/singleprocessor")
+
 type SingleProcessor struct {
 	taskRepo           taskrepo.TaskRepository
 	failedTaskRepo     failedtaskrepo.FailedTaskRepository
+	attemptRepo        taskattemptrepo.TaskAttemptRepository
 	txManager 		   txmanager.TxManager
 	randomProvider     random.RandomProvider
+	handlers           taskhandler.Registry
+	lease              domain.Lease
+	// releaseTimeout bounds how long storing an outcome or releasing a task
+	// outlives the cancelled context of the run
+	releaseTimeout     time.Duration
+	retryBackoff       *backoff.Policy
+	notifier           *webhook.Notifier
+	outbox             *outbox.Writer
+	stream             *stream.Emitter
+	now                func() time.Time
 }
 
 func NewSingleProcessor(
 	taskRepo 	   taskrepo.TaskRepository,
 	failedTaskRepo failedtaskrepo.FailedTaskRepository,
+	attemptRepo    taskattemptrepo.TaskAttemptRepository,
 	txManager 	   txmanager.TxManager,
 	randomProvider random.RandomProvider,
+	handlers       taskhandler.Registry,
+	lease          domain.Lease,
+	releaseTimeout time.Duration,
+	retryBackoff   *backoff.Policy,
+	notifier       *webhook.Notifier,
+	outbox         *outbox.Writer,
+	stream         *stream.Emitter,
 ) *SingleProcessor {
 	return &SingleProcessor{
 		taskRepo:           taskRepo,
 		failedTaskRepo:     failedTaskRepo,
+		attemptRepo:        attemptRepo,
 		txManager: 			txManager,
 		randomProvider:     randomProvider,
+		handlers:           handlers,
+		lease:              lease,
+		releaseTimeout:     releaseTimeout,
+		retryBackoff:       retryBackoff,
+		notifier:           notifier,
+		outbox:             outbox,
+		stream:             stream,
+		now:                time.Now,
 	}
 }
 
+// ProcessTask runs the task and stores its outcome. The lease of the task is
+// kept alive by the caller from acquisition on, ctx is cancelled with
+// domain.ErrLeaseLost when it is lost.
 func (s *SingleProcessor) ProcessTask(
 	ctx context.Context,
 	task *domain.Task,
 	request *tasksprocessor.ProcessTasksRequest,
 ) (bool, error) {
-	if task.Attempts >= task.MaxAttempts {
-		return s.handleMaxAttemptsExceeded(ctx, task)
+	// Acquisition only hands out tasks with attempts left, this guards against stale rows
+	if task.Attempts > task.MaxAttempts {
+		return s.moveToDeadLetter(ctx, task, nil)
 	}
 
+	startedAt := s.now()
+
+	// Cancelled before the handler ran, the attempt was never made
 	if err := s.applyProcessingDelay(ctx, request); err != nil {
-		return false, err
+		if cause := context.Cause(ctx); errors.Is(cause, domain.ErrLeaseLost) {
+			return false, cause
+		}
+		return false, s.release(ctx, task, nil)
+	}
+
+	result, err := s.dispatch(ctx, task)
+	if err != nil {
+		// Another instance owns the task now, its outcome is not ours to record
+		if errors.Is(err, domain.ErrLeaseLost) {
+			return false, s.abandon(ctx, s.finishAttempt(task, startedAt, domain.AttemptLeaseLost, err), err)
+		}
+		// The handler was interrupted rather than failed on its own
+		if ctx.Err() != nil {
+			return false, s.release(ctx, task, s.finishAttempt(task, startedAt, domain.AttemptInterrupted, err))
+		}
+		return s.handleFailedProcessing(ctx, task, s.finishAttempt(task, startedAt, domain.AttemptFailed, err), err)
+	}
+
+	return s.handleSuccessfulProcessing(ctx, task, s.finishAttempt(task, startedAt, domain.AttemptSucceeded, nil), result)
+}
+
+// finishAttempt describes the attempt that has just ended
+func (s *SingleProcessor) finishAttempt(
+	task *domain.Task,
+	startedAt time.Time,
+	outcome domain.AttemptOutcome,
+	err error,
+) *domain.TaskAttempt {
+	attempt := &domain.TaskAttempt{
+		TaskID:     task.ID,
+		Number:     task.Attempts,
+		Owner:      s.lease.Owner,
+		Outcome:    outcome,
+		StartedAt:  startedAt,
+		FinishedAt: s.now(),
+	}
+	if err != nil {
+		attempt.ErrorMessage = err.Error()
+	}
+	return attempt
+}
+
+func (s *SingleProcessor) recordAttempt(ctx context.Context, attempt *domain.TaskAttempt) error {
+	if err := s.attemptRepo.Create(ctx, attempt); err != nil {
+		return fmt.Errorf("failed to record attempt: %w", err)
+	}
+	return nil
+}
+
+// abandon records the attempt of a task whose lease was lost before its
+// outcome could be stored, leaving the task to the instance that owns it now
+func (s *SingleProcessor) abandon(ctx context.Context, attempt *domain.TaskAttempt, err error) error {
+	if attempt == nil {
+		return err
+	}
+	attempt.Outcome = domain.AttemptLeaseLost
+	if attempt.ErrorMessage == "" {
+		attempt.ErrorMessage = err.Error()
+	}
+	return errors.Join(err, s.recordAttempt(ctx, attempt))
+}
+
+// release returns a task whose processing was cancelled to the queue without
+// counting the attempt, along with the interrupted attempt if the handler ran.
+// It outlives the cancelled context for at most releaseTimeout; a task that
+// could not be released is picked up again once its lease expires.
+func (s *SingleProcessor) release(ctx context.Context, task *domain.Task, attempt *domain.TaskAttempt) error {
+	cause := ctx.Err()
+
+	releaseCtx, cancel := s.detach(ctx)
+	defer cancel()
+
+	err := s.txManager.WithTransaction(releaseCtx, func(ctx context.Context) error {
+		if err := s.taskRepo.ReleaseLease(ctx, task.ID, s.lease); err != nil {
+			return fmt.Errorf("failed to release task: %w", err)
+		}
+		if attempt != nil {
+			if err := s.recordAttempt(ctx, attempt); err != nil {
+				return err
+			}
+		}
+		task.Status = domain.StatusNew
+		task.Attempts = max(task.Attempts-1, 0)
+		return s.outbox.Record(ctx, domain.EventReleased, task)
+	})
+	if err != nil {
+		return errors.Join(cause, err)
+	}
+	s.stream.Emit(domain.EventReleased, task)
+	return errors.Join(cause, domain.ErrTaskReleased)
+}
+
+// detach returns a context unaffected by the cancellation of ctx for at most
+// releaseTimeout, so work finished as the run is cancelled is not thrown away
+func (s *SingleProcessor) detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), s.releaseTimeout)
+}
+
+// dispatch runs the handler registered for the task type. A handler stopped
+// because the lease was lost reports domain.ErrLeaseLost.
+func (s *SingleProcessor) dispatch(
+	ctx context.Context,
+	task *domain.Task,
+) (json.RawMessage, error) {
+	handler, ok := s.handlers.Get(task.Type)
+	if !ok {
+		return nil, fmt.Errorf("no handler registered for task type %q", task.Type)
 	}
 
-	isSuccess := s.randomProvider.Float64() <= request.SuccessRate
+	ctx, span := tracer.Start(ctx, "task.handle", trace.WithAttributes(attribute.String("task.type", task.Type)))
+	defer span.End()
 
-	if isSuccess {
-		return s.handleSuccessfulProcessing(ctx, task)
-	} else {
-		return s.handleFailedProcessing(ctx, task, request)
+	result, err := handler.Handle(ctx, task)
+
+	if cause := context.Cause(ctx); errors.Is(cause, domain.ErrLeaseLost) {
+		err = cause
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
 	}
+	return result, nil
 }
 
-func (s *SingleProcessor) handleMaxAttemptsExceeded(
+// moveToDeadLetter removes the task from the queue and stores it in failed_tasks atomically,
+// along with the attempt that exhausted it if there was one
+func (s *SingleProcessor) moveToDeadLetter(
 	ctx context.Context,
 	task *domain.Task,
+	attempt *domain.TaskAttempt,
 ) (bool, error) {
+	ctx, cancel := s.detach(ctx)
+	defer cancel()
+
+	task.Status = domain.StatusFailed
 	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
-		if err := s.taskRepo.Delete(ctx, task.ID); err != nil {
+		if err := s.taskRepo.Delete(ctx, task.ID, s.lease); err != nil {
 			return fmt.Errorf("failed to delete task: %w", err)
 		}
 		if err := s.failedTaskRepo.Create(ctx, task); err != nil {
 			return fmt.Errorf("failed to create failed task record: %w", err)
 		}
-		return nil
+		if attempt != nil {
+			if err := s.recordAttempt(ctx, attempt); err != nil {
+				return err
+			}
+		}
+		if err := s.outbox.Record(ctx, domain.EventDeadLettered, task); err != nil {
+			return err
+		}
+		return s.notifier.Notify(ctx, domain.WebhookTaskDeadLettered, task)
 	})
+	if errors.Is(err, domain.ErrLeaseLost) {
+		return false, s.abandon(ctx, attempt, err)
+	}
+	if err != nil {
+		return false, err
+	}
 
-	return false, err
+	s.stream.Emit(domain.EventDeadLettered, task)
+	return false, nil
 }
 
 func (s *SingleProcessor) applyProcessingDelay(
 	ctx context.Context,
 	request *tasksprocessor.ProcessTasksRequest,
 ) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	if request.MinDelayMS > 0 || request.MaxDelayMS > 0 {
 		minDelay := max(request.MinDelayMS, 0)
 		maxDelay := max(request.MaxDelayMS, minDelay)
 		
 		delayMS := s.randomProvider.Intn(maxDelay-minDelay+1) + minDelay
+
+		_, span := tracer.Start(ctx, "task.delay", trace.WithAttributes(attribute.Int("delay.ms", delayMS)))
+		defer span.End()
 		
 		select {
 		case <-ctx.Done():
@@ -94,23 +287,75 @@ func (s *SingleProcessor) applyProcessingDelay(
 func (s *SingleProcessor) handleSuccessfulProcessing(
 	ctx context.Context,
 	task *domain.Task,
+	attempt *domain.TaskAttempt,
+	result json.RawMessage,
 ) (bool, error) {
-	if err := s.taskRepo.MarkAsProcessed(ctx, task.ID); err != nil {
-		return false, fmt.Errorf("failed to mark task as processed: %w", err)
+	// The handler succeeded, a cancellation arriving now must not lose its result
+	ctx, cancel := s.detach(ctx)
+	defer cancel()
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.taskRepo.MarkAsProcessed(ctx, task.ID, s.lease, result); err != nil {
+			return fmt.Errorf("failed to mark task as processed: %w", err)
+		}
+		if err := s.recordAttempt(ctx, attempt); err != nil {
+			return err
+		}
+		task.Status = domain.StatusProcessed
+		task.Result = result
+		if err := s.outbox.Record(ctx, domain.EventProcessed, task); err != nil {
+			return err
+		}
+		return s.notifier.Notify(ctx, domain.WebhookTaskProcessed, task)
+	})
+	if errors.Is(err, domain.ErrLeaseLost) {
+		return false, s.abandon(ctx, attempt, err)
 	}
+	if err != nil {
+		return false, err
+	}
+
+	s.stream.Emit(domain.EventProcessed, task)
 	return true, nil
 }
 
 func (s *SingleProcessor) handleFailedProcessing(
 	ctx context.Context,
 	task *domain.Task,
-	request *tasksprocessor.ProcessTasksRequest,
+	attempt *domain.TaskAttempt,
+	handlerErr error,
 ) (bool, error) {
-	errorMsg := fmt.Sprintf("processing failed according to success rate %.2f (attempt %d/%d)",
-		request.SuccessRate, task.Attempts, task.MaxAttempts)
+	ctx, cancel := s.detach(ctx)
+	defer cancel()
+
+	errorMsg := fmt.Sprintf("%s (attempt %d/%d)", handlerErr.Error(), task.Attempts, task.MaxAttempts)
+
+	// That was the last attempt, there is nothing left to retry
+	if task.Attempts >= task.MaxAttempts {
+		task.ErrorMessage = errorMsg
+		return s.moveToDeadLetter(ctx, task, attempt)
+	}
+
+	retryAfter := s.retryBackoff.Delay(task.Attempts)
 
-	if err := s.taskRepo.MarkAsFailed(ctx, task.ID, errorMsg); err != nil {
-		return false, fmt.Errorf("failed to mark task as failed: %w", err)
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.taskRepo.MarkAsFailed(ctx, task.ID, s.lease, errorMsg, retryAfter); err != nil {
+			return fmt.Errorf("failed to mark task as failed: %w", err)
+		}
+		if err := s.recordAttempt(ctx, attempt); err != nil {
+			return err
+		}
+		task.Status = domain.StatusFailed
+		task.ErrorMessage = errorMsg
+		return s.outbox.Record(ctx, domain.EventFailed, task)
+	})
+	if errors.Is(err, domain.ErrLeaseLost) {
+		return false, s.abandon(ctx, attempt, err)
+	}
+	if err != nil {
+		return false, err
 	}
+
+	s.stream.Emit(domain.EventFailed, task)
 	return false, nil
 }
\ No newline at end of file
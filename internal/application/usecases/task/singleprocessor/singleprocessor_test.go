@@ -2,77 +2,412 @@ package singleprocessor
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"task-processor/internal/application/ports/inbound/random"
 	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
 	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/application/ports/outbound/taskhandler"
+	"task-processor/internal/application/ports/outbound/taskstream"
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/usecases/task/backoff"
+	"task-processor/internal/application/usecases/task/outbox"
+	"task-processor/internal/application/usecases/task/stream"
+	"task-processor/internal/application/usecases/task/webhook"
 	"task-processor/internal/domain"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+var testLease = domain.Lease{Owner: "test-worker", Duration: time.Minute}
+
+const testReleaseTimeout = time.Second
+
+var testBackoff = backoff.NewPolicy(backoff.Config{
+	Base:       time.Second,
+	Multiplier: 2,
+	Cap:        time.Minute,
+	Jitter:     backoff.JitterNone,
+}, nil)
+
+// testNotifier never queues webhooks, tests of notifications build their own
+var testNotifier = webhook.NewNotifier(nil, false, nil)
+
+// testOutbox never writes messages, tests of the outbox build their own
+var testOutbox = outbox.NewWriter(nil, false)
+
+// testStream discards live events, tests of the event stream build their own
+var testStream = stream.NewEmitter(nil)
+
+// steppingClock returns start on the first call and advances by step on every call after it
+func steppingClock(start time.Time, step time.Duration) func() time.Time {
+	next := start
+	return func() time.Time {
+		now := next
+		next = next.Add(step)
+		return now
+	}
+}
+
 func TestProcessTask_Success(t *testing.T) {
 	ctx := context.Background()
+	start := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
 	mockRepo := new(taskrepo.MockTaskRepository)
 	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
 	mockTx := new(txmanager.MockTxManager)
 	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
 
-	task := &domain.Task{ID: uuid.New(), Attempts: 0, MaxAttempts: 3}
-	req := &tasksprocessor.ProcessTasksRequest{SuccessRate: 1.0, MinDelayMS: 0, MaxDelayMS: 0}
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+	req := &tasksprocessor.ProcessTasksRequest{MinDelayMS: 0, MaxDelayMS: 0}
+	result := json.RawMessage(`{"sent":true}`)
 
-	mockRand.On("Float64").Return(0.5)
-	mockRepo.On("MarkAsProcessed", ctx, task.ID).Return(nil)
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(result, nil)
+	mockRepo.On("MarkAsProcessed", mock.Anything, task.ID, testLease, result).Return(nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockAttempts.On("Create", mock.Anything, &domain.TaskAttempt{
+		TaskID:     task.ID,
+		Number:     1,
+		Owner:      "test-worker",
+		Outcome:    domain.AttemptSucceeded,
+		StartedAt:  start,
+		FinishedAt: start.Add(250 * time.Millisecond),
+	}).Return(nil)
 
-	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockTx, mockRand)
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	pr.now = steppingClock(start, 250*time.Millisecond)
 	success, err := pr.ProcessTask(ctx, task, req)
 
 	assert.True(t, success)
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
-	mockRand.AssertExpectations(t)
+	mockHandler.AssertExpectations(t)
+	mockRegistry.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
 }
 
 func TestProcessTask_Failure(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(taskrepo.MockTaskRepository)
 	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
 	mockTx := new(txmanager.MockTxManager)
 	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
 
-	task := &domain.Task{ID: uuid.New(), Attempts: 1, MaxAttempts: 3}
-	req := &tasksprocessor.ProcessTasksRequest{SuccessRate: 0.0, MinDelayMS: 0, MaxDelayMS: 0}
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+	req := &tasksprocessor.ProcessTasksRequest{MinDelayMS: 0, MaxDelayMS: 0}
 
-	mockRand.On("Float64").Return(1.0)
-	mockRepo.On("MarkAsFailed", ctx, task.ID, mock.Anything).Return(nil)
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(nil, errors.New("smtp unavailable"))
+	mockRepo.On("MarkAsFailed", mock.Anything, task.ID, testLease, "smtp unavailable (attempt 1/3)", time.Second).Return(nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Outcome == domain.AttemptFailed
+	})).Return(nil)
 
-	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockTx, mockRand)
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
 	success, err := pr.ProcessTask(ctx, task, req)
 
 	assert.False(t, success)
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
-	mockRand.AssertExpectations(t)
+	mockHandler.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestProcessTask_FailureBackoffGrowsWithAttempts(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 3, MaxAttempts: 5}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(nil, errors.New("smtp unavailable"))
+	mockRepo.On("MarkAsFailed", mock.Anything, task.ID, testLease, "smtp unavailable (attempt 3/5)", 4*time.Second).Return(nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Outcome == domain.AttemptFailed
+	})).Return(nil)
+
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestProcessTask_UnknownType(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "unknown", Attempts: 1, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "unknown").Return(nil, false)
+	mockRepo.On("MarkAsFailed", mock.Anything, task.ID, testLease, mock.MatchedBy(func(msg string) bool {
+		return assert.Contains(t, msg, `no handler registered for task type "unknown"`)
+	}), time.Second).Return(nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Outcome == domain.AttemptFailed
+	})).Return(nil)
+
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestProcessTask_HandlerInterrupted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Run(func(mock.Arguments) { cancel() }).Return(nil, context.Canceled)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	// The release runs on a context detached from the cancelled one
+	mockRepo.On("ReleaseLease", mock.MatchedBy(func(c context.Context) bool { return c.Err() == nil }), task.ID, testLease).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Number == 1 && a.Outcome == domain.AttemptInterrupted
+	})).Return(nil)
+
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, domain.ErrTaskReleased)
+	assert.Equal(t, domain.StatusNew, task.Status)
+	assert.Equal(t, 0, task.Attempts)
+	mockRepo.AssertNotCalled(t, "MarkAsFailed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestProcessTask_CancelledBeforeHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 2, MaxAttempts: 3}
+
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("ReleaseLease", mock.Anything, task.ID, testLease).Return(nil)
+
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.ErrorIs(t, err, domain.ErrTaskReleased)
+	assert.Equal(t, 1, task.Attempts)
+	mockRegistry.AssertNotCalled(t, "Get", mock.Anything)
+	mockAttempts.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProcessTask_ReleaseFails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	// Reclaimed by the reaper in the meantime
+	mockRepo.On("ReleaseLease", mock.Anything, task.ID, testLease).Return(domain.ErrLeaseLost)
+
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, domain.ErrLeaseLost)
+	assert.NotErrorIs(t, err, domain.ErrTaskReleased)
+	assert.Equal(t, 1, task.Attempts)
+}
+
+func TestProcessTask_LeaseLost(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "report", Attempts: 1, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "report").Return(mockHandler, true)
+	// Long-running handler stopped by the lease keeper once the lease is lost
+	mockHandler.On("Handle", mock.Anything, task).Run(func(args mock.Arguments) {
+		cancel(domain.ErrLeaseLost)
+		<-args.Get(0).(context.Context).Done()
+	}).Return(nil, context.Canceled)
+	mockAttempts.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Outcome == domain.AttemptLeaseLost
+	})).Return(nil)
+
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.ErrorIs(t, err, domain.ErrLeaseLost)
+	mockRepo.AssertNotCalled(t, "MarkAsFailed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "ReleaseLease", mock.Anything, mock.Anything, mock.Anything)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestProcessTask_LeaseLostBeforeHandler(t *testing.T) {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockRand := new(random.MockRandom)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "report", Attempts: 1, MaxAttempts: 3}
+
+	// The lease ran out while the task waited for a worker
+	cancel(domain.ErrLeaseLost)
+
+	pr := NewSingleProcessor(mockRepo, nil, nil, nil, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.ErrorIs(t, err, domain.ErrLeaseLost)
+	assert.NotErrorIs(t, err, domain.ErrTaskReleased)
+	mockRepo.AssertNotCalled(t, "ReleaseLease", mock.Anything, mock.Anything, mock.Anything)
+	mockRegistry.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func TestProcessTask_StoresOutcomeWhenCancelledAfterHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+	result := json.RawMessage(`{"sent":true}`)
+	live := mock.MatchedBy(func(ctx context.Context) bool { return ctx.Err() == nil })
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	// The handler finishes just as the run is cancelled, e.g. by the drain timeout
+	mockHandler.On("Handle", mock.Anything, task).Run(func(mock.Arguments) {
+		cancel()
+	}).Return(result, nil)
+	mockTx.On("WithTransaction", live, mock.Anything).Return(nil)
+	mockRepo.On("MarkAsProcessed", live, task.ID, testLease, result).Return(nil)
+	mockAttempts.On("Create", live, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Outcome == domain.AttemptSucceeded
+	})).Return(nil)
+
+	pr := NewSingleProcessor(mockRepo, nil, mockAttempts, mockTx, nil, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.True(t, success)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.StatusProcessed, task.Status)
+	mockRepo.AssertExpectations(t)
+	mockAttempts.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "ReleaseLease", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestProcessTask_LeaseLostBeforeOutcomeStored(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+	publisher := new(taskstream.MockPublisher)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(json.RawMessage(`{}`), nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	// The lease expired while the handler ran and the task was reclaimed
+	mockRepo.On("MarkAsProcessed", mock.Anything, task.ID, testLease, mock.Anything).Return(domain.ErrLeaseLost)
+	mockAttempts.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Outcome == domain.AttemptLeaseLost && strings.Contains(a.ErrorMessage, domain.ErrLeaseLost.Error())
+	})).Return(nil).Once()
+
+	pr := NewSingleProcessor(mockRepo, nil, mockAttempts, mockTx, nil, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, stream.NewEmitter(publisher))
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.ErrorIs(t, err, domain.ErrLeaseLost)
+	assert.NotEqual(t, domain.StatusProcessed, task.Status)
+	mockAttempts.AssertExpectations(t)
+	publisher.AssertNotCalled(t, "Publish", mock.Anything)
 }
 
 func TestProcessTask_MaxAttemptsExceeded(t *testing.T) {
 	ctx := context.Background()
 	mockRepo := new(taskrepo.MockTaskRepository)
 	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
 	mockTx := new(txmanager.MockTxManager)
 	mockRand := new(random.MockRandom)
+	mockRegistry := new(taskhandler.MockRegistry)
 
-	task := &domain.Task{ID: uuid.New(), Attempts: 3, MaxAttempts: 3}
+	task := &domain.Task{ID: uuid.New(), Attempts: 4, MaxAttempts: 3}
 
-	mockTx.On("WithTransaction", ctx, mock.Anything).Return(nil)
-	mockRepo.On("Delete", ctx, task.ID).Return(nil)
-	mockFailedRepo.On("Create", ctx, task).Return(nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("Delete", mock.Anything, task.ID, testLease).Return(nil)
+	mockFailedRepo.On("Create", mock.Anything, task).Return(nil)
 
-	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockTx, mockRand)
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
 	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
 
 	assert.False(t, success)
@@ -80,4 +415,203 @@ func TestProcessTask_MaxAttemptsExceeded(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 	mockFailedRepo.AssertExpectations(t)
 	mockTx.AssertExpectations(t)
+	mockRegistry.AssertNotCalled(t, "Get", mock.Anything)
+	// No handler ran, so there is no attempt to record
+	mockAttempts.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestProcessTask_LastAttemptFailedMovesToDeadLetter(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Status: domain.StatusProcessing, Attempts: 3, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(nil, errors.New("smtp unavailable"))
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("Delete", mock.Anything, task.ID, testLease).Return(nil)
+	mockFailedRepo.On("Create", mock.Anything, mock.MatchedBy(func(dead *domain.Task) bool {
+		return dead.ID == task.ID &&
+			dead.Status == domain.StatusFailed &&
+			dead.ErrorMessage == "smtp unavailable (attempt 3/3)"
+	})).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.MatchedBy(func(a *domain.TaskAttempt) bool {
+		return a.TaskID == task.ID && a.Outcome == domain.AttemptFailed
+	})).Return(nil)
+
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockFailedRepo.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "MarkAsFailed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockAttempts.AssertExpectations(t)
+}
+
+func TestProcessTask_SuccessQueuesWebhook(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+	mockWebhooks := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3, CallbackURL: "https://example.com/hook"}
+	result := json.RawMessage(`{"sent":true}`)
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(result, nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("MarkAsProcessed", mock.Anything, task.ID, testLease, result).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mockWebhooks.On("Create", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		var payload webhook.Payload
+		return d.TaskID == task.ID &&
+			d.Event == domain.WebhookTaskProcessed &&
+			d.URL == "https://example.com/hook" &&
+			json.Unmarshal(d.Payload, &payload) == nil &&
+			payload.Status == domain.StatusProcessed &&
+			string(payload.Result) == `{"sent":true}`
+	})).Return(nil)
+
+	notifier := webhook.NewNotifier(mockWebhooks, true, nil)
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, notifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.True(t, success)
+	assert.NoError(t, err)
+	mockWebhooks.AssertExpectations(t)
+}
+
+func TestProcessTask_DeadLetterQueuesWebhook(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+	mockWebhooks := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 3, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(nil, errors.New("smtp unavailable"))
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("Delete", mock.Anything, task.ID, testLease).Return(nil)
+	mockFailedRepo.On("Create", mock.Anything, task).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.Anything).Return(nil)
+	// No callback URL on the task, the type default applies
+	mockWebhooks.On("Create", mock.Anything, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.Event == domain.WebhookTaskDeadLettered && d.URL == "https://example.com/email"
+	})).Return(nil)
+
+	notifier := webhook.NewNotifier(mockWebhooks, true, map[string]string{"email": "https://example.com/email"})
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, notifier, testOutbox, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.NoError(t, err)
+	mockWebhooks.AssertExpectations(t)
+}
+
+func TestProcessTask_RetryWritesOutbox(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockRand := new(random.MockRandom)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+	mockOutbox := new(outboxrepo.MockOutboxRepository)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(nil, errors.New("smtp unavailable"))
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("MarkAsFailed", mock.Anything, task.ID, testLease, mock.Anything, time.Second).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.MatchedBy(func(messages []*domain.OutboxMessage) bool {
+		var message outbox.Message
+		return len(messages) == 1 &&
+			messages[0].TaskID == task.ID &&
+			messages[0].Event == domain.EventFailed &&
+			json.Unmarshal(messages[0].Payload, &message) == nil &&
+			message.Status == domain.StatusFailed &&
+			message.ErrorMessage == "smtp unavailable (attempt 1/3)"
+	})).Return(nil)
+
+	writer := outbox.NewWriter(mockOutbox, true)
+	pr := NewSingleProcessor(mockRepo, mockFailedRepo, mockAttempts, mockTx, mockRand, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, writer, testStream)
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.NoError(t, err)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestProcessTask_StreamsOutcomeAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockAttempts := new(taskattemptrepo.MockTaskAttemptRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+	publisher := new(taskstream.MockPublisher)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(json.RawMessage(`{}`), nil)
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("MarkAsProcessed", mock.Anything, task.ID, testLease, mock.Anything).Return(nil)
+	mockAttempts.On("Create", mock.Anything, mock.Anything).Return(nil)
+	publisher.On("Publish", mock.MatchedBy(func(event *domain.StreamEvent) bool {
+		return event.Type == domain.EventProcessed && event.TaskID == task.ID && event.Status == domain.StatusProcessed
+	})).Return().Once()
+
+	pr := NewSingleProcessor(mockRepo, nil, mockAttempts, mockTx, nil, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, stream.NewEmitter(publisher))
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.True(t, success)
+	assert.NoError(t, err)
+	publisher.AssertExpectations(t)
+}
+
+func TestProcessTask_DoesNotStreamRolledBackOutcome(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockTx := new(txmanager.MockTxManager)
+	mockHandler := new(taskhandler.MockTaskHandler)
+	mockRegistry := new(taskhandler.MockRegistry)
+	publisher := new(taskstream.MockPublisher)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3}
+
+	mockRegistry.On("Get", "email").Return(mockHandler, true)
+	mockHandler.On("Handle", mock.Anything, task).Return(nil, errors.New("smtp unavailable"))
+	mockTx.On("WithTransaction", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("MarkAsFailed", mock.Anything, task.ID, testLease, mock.Anything, time.Second).Return(errors.New("db down"))
+
+	pr := NewSingleProcessor(mockRepo, nil, nil, mockTx, nil, mockRegistry, testLease, testReleaseTimeout, testBackoff, testNotifier, testOutbox, stream.NewEmitter(publisher))
+	success, err := pr.ProcessTask(ctx, task, &tasksprocessor.ProcessTasksRequest{})
+
+	assert.False(t, success)
+	assert.Error(t, err)
+	publisher.AssertNotCalled(t, "Publish", mock.Anything)
 }
\ No newline at end of file
@@ -0,0 +1,21 @@
+package stats
+
+import (
+	"context"
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockStats struct {
+	mock.Mock
+}
+
+func (m *MockStats) GetStats(ctx context.Context) (*domain.QueueStats, error) {
+	args := m.Called(ctx)
+	var stats *domain.QueueStats
+	if s := args.Get(0); s != nil {
+		stats = s.(*domain.QueueStats)
+	}
+	return stats, args.Error(1)
+}
\ No newline at end of file
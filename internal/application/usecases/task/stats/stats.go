@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// Stats collects queue statistics, reusing a snapshot for cacheTTL
+// so dashboards polling many times a second cost one query per TTL
+type Stats struct {
+	taskRepo       taskrepo.TaskRepository
+	failedTaskRepo failedtaskrepo.FailedTaskRepository
+	cacheTTL       time.Duration
+	now            func() time.Time
+
+	// mu also serialises collection, so concurrent callers on an
+	// expired snapshot wait for one query instead of issuing their own
+	mu       sync.Mutex
+	snapshot *domain.QueueStats
+}
+
+// NewStats creates the use case; a zero cacheTTL collects on every call
+func NewStats(
+	taskRepo       taskrepo.TaskRepository,
+	failedTaskRepo failedtaskrepo.FailedTaskRepository,
+	cacheTTL       time.Duration,
+) *Stats {
+	return &Stats{
+		taskRepo:       taskRepo,
+		failedTaskRepo: failedTaskRepo,
+		cacheTTL:       cacheTTL,
+		now:            time.Now,
+	}
+}
+
+// GetStats returns a snapshot of the queue at most cacheTTL old
+func (s *Stats) GetStats(ctx context.Context) (*domain.QueueStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.snapshot != nil && now.Sub(s.snapshot.CollectedAt) < s.cacheTTL {
+		return s.snapshot, nil
+	}
+
+	stats, err := s.taskRepo.Stats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect task stats: %w", err)
+	}
+
+	stats.DeadLettered, err = s.failedTaskRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count dead-lettered tasks: %w", err)
+	}
+	stats.CollectedAt = now
+
+	s.snapshot = stats
+	return stats, nil
+}
\ No newline at end of file
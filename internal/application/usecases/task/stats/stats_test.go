@@ -0,0 +1,135 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStats returns the use case with a controllable clock
+func newTestStats(ttl time.Duration) (*Stats, *taskrepo.MockTaskRepository, *failedtaskrepo.MockFailedTaskRepo, *time.Time) {
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	s := NewStats(mockRepo, mockFailedRepo, ttl)
+	s.now = func() time.Time { return now }
+	return s, mockRepo, mockFailedRepo, &now
+}
+
+// newRepoStats returns fresh repository stats on every call
+func newRepoStats(oldestNewAt time.Time) func() *domain.QueueStats {
+	return func() *domain.QueueStats {
+		return &domain.QueueStats{
+			ByStatus: map[domain.TaskStatus]int{
+				domain.StatusNew:        5,
+				domain.StatusProcessing: 2,
+				domain.StatusFailed:     3,
+			},
+			Exhausted:   1,
+			OldestNewAt: &oldestNewAt,
+		}
+	}
+}
+
+func TestGetStats_CombinesRepositories(t *testing.T) {
+	ctx := context.Background()
+	s, mockRepo, mockFailedRepo, now := newTestStats(0)
+
+	oldest := now.Add(-90 * time.Second)
+	mockRepo.On("Stats", ctx).Return(newRepoStats(oldest)(), nil)
+	mockFailedRepo.On("Count", ctx).Return(7, nil)
+
+	stats, err := s.GetStats(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, stats.Count(domain.StatusNew))
+	assert.Equal(t, 2, stats.Count(domain.StatusProcessing))
+	assert.Zero(t, stats.Count(domain.StatusProcessed))
+	assert.Equal(t, 1, stats.Exhausted)
+	assert.Equal(t, 7, stats.DeadLettered)
+	assert.Equal(t, *now, stats.CollectedAt)
+	assert.Equal(t, 90*time.Second, stats.OldestNewAge())
+	mockRepo.AssertExpectations(t)
+	mockFailedRepo.AssertExpectations(t)
+}
+
+func TestGetStats_CachesWithinTTL(t *testing.T) {
+	ctx := context.Background()
+	s, mockRepo, mockFailedRepo, now := newTestStats(2 * time.Second)
+
+	mockRepo.On("Stats", ctx).Return(newRepoStats(*now)(), nil).Once()
+	mockFailedRepo.On("Count", ctx).Return(0, nil).Once()
+
+	first, err := s.GetStats(ctx)
+	require.NoError(t, err)
+
+	*now = now.Add(time.Second)
+	second, err := s.GetStats(ctx)
+	require.NoError(t, err)
+
+	assert.Same(t, first, second)
+	mockRepo.AssertNumberOfCalls(t, "Stats", 1)
+	mockFailedRepo.AssertNumberOfCalls(t, "Count", 1)
+}
+
+func TestGetStats_RefreshesAfterTTL(t *testing.T) {
+	ctx := context.Background()
+	s, mockRepo, mockFailedRepo, now := newTestStats(2 * time.Second)
+
+	mockRepo.On("Stats", ctx).Return(newRepoStats(*now)(), nil).Once()
+	mockRepo.On("Stats", ctx).Return(newRepoStats(*now)(), nil).Once()
+	mockFailedRepo.On("Count", ctx).Return(0, nil).Once()
+	mockFailedRepo.On("Count", ctx).Return(4, nil).Once()
+
+	_, err := s.GetStats(ctx)
+	require.NoError(t, err)
+
+	*now = now.Add(2 * time.Second)
+	stats, err := s.GetStats(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, stats.DeadLettered)
+	assert.Equal(t, *now, stats.CollectedAt)
+	mockRepo.AssertExpectations(t)
+	mockFailedRepo.AssertExpectations(t)
+}
+
+func TestGetStats_ErrorIsNotCached(t *testing.T) {
+	ctx := context.Background()
+	s, mockRepo, mockFailedRepo, now := newTestStats(time.Minute)
+
+	mockRepo.On("Stats", ctx).Return(nil, errors.New("db down")).Once()
+	mockRepo.On("Stats", ctx).Return(newRepoStats(*now)(), nil).Once()
+	mockFailedRepo.On("Count", ctx).Return(0, nil).Once()
+
+	_, err := s.GetStats(ctx)
+	assert.Error(t, err)
+
+	stats, err := s.GetStats(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, stats.Count(domain.StatusNew))
+	mockRepo.AssertExpectations(t)
+	mockFailedRepo.AssertExpectations(t)
+}
+
+func TestGetStats_EmptyQueue(t *testing.T) {
+	ctx := context.Background()
+	s, mockRepo, mockFailedRepo, _ := newTestStats(0)
+
+	mockRepo.On("Stats", ctx).Return(&domain.QueueStats{ByStatus: map[domain.TaskStatus]int{}}, nil)
+	mockFailedRepo.On("Count", ctx).Return(0, nil)
+
+	stats, err := s.GetStats(ctx)
+
+	require.NoError(t, err)
+	assert.Nil(t, stats.OldestNewAt)
+	assert.Zero(t, stats.OldestNewAge())
+}
\ No newline at end of file
@@ -0,0 +1,42 @@
+package stream
+
+import (
+	"task-processor/internal/application/ports/outbound/taskstream"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// Emitter pushes lifecycle events to live subscribers. Callers emit once the
+// change is committed, so subscribers never see a transition that was rolled back.
+type Emitter struct {
+	publisher taskstream.Publisher
+	now       func() time.Time
+}
+
+// NewEmitter returns an emitter publishing to publisher, a nil publisher discards events
+func NewEmitter(publisher taskstream.Publisher) *Emitter {
+	return &Emitter{
+		publisher: publisher,
+		now:       time.Now,
+	}
+}
+
+// Emit publishes one event per task describing event
+func (e *Emitter) Emit(event domain.TaskEventType, tasks ...*domain.Task) {
+	if e.publisher == nil {
+		return
+	}
+
+	occurredAt := e.now().UTC()
+	for _, task := range tasks {
+		e.publisher.Publish(&domain.StreamEvent{
+			Type:       event,
+			TaskID:     task.ID,
+			TaskType:   task.Type,
+			Status:     task.Status,
+			Attempt:    task.Attempts,
+			Message:    task.ErrorMessage,
+			OccurredAt: occurredAt,
+		})
+	}
+}
\ No newline at end of file
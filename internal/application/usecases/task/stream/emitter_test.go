@@ -0,0 +1,46 @@
+package stream
+
+import (
+	"task-processor/internal/application/ports/outbound/taskstream"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEmit_PublishesOneEventPerTask(t *testing.T) {
+	publisher := new(taskstream.MockPublisher)
+	publisher.On("Publish", mock.Anything).Return()
+
+	now := time.Date(2025, 10, 21, 12, 0, 0, 0, time.UTC)
+	emitter := NewEmitter(publisher)
+	emitter.now = func() time.Time { return now }
+
+	tasks := []*domain.Task{
+		{ID: uuid.New(), Type: "email", Status: domain.StatusFailed, Attempts: 2, ErrorMessage: "boom (attempt 2/3)"},
+		{ID: uuid.New(), Type: "report", Status: domain.StatusFailed, Attempts: 1},
+	}
+	emitter.Emit(domain.EventFailed, tasks...)
+
+	publisher.AssertNumberOfCalls(t, "Publish", 2)
+	event := publisher.Calls[0].Arguments.Get(0).(*domain.StreamEvent)
+	assert.Equal(t, &domain.StreamEvent{
+		Type:       domain.EventFailed,
+		TaskID:     tasks[0].ID,
+		TaskType:   "email",
+		Status:     domain.StatusFailed,
+		Attempt:    2,
+		Message:    "boom (attempt 2/3)",
+		OccurredAt: now,
+	}, event)
+	assert.Equal(t, tasks[1].ID, publisher.Calls[1].Arguments.Get(0).(*domain.StreamEvent).TaskID)
+}
+
+func TestEmit_WithoutPublisher(t *testing.T) {
+	assert.NotPanics(t, func() {
+		NewEmitter(nil).Emit(domain.EventProcessed, &domain.Task{ID: uuid.New()})
+	})
+}
\ No newline at end of file
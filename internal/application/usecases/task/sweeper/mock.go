@@ -0,0 +1,16 @@
+package sweeper
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockSweeper struct {
+	mock.Mock
+}
+
+func (m *MockSweeper) SweepExhausted(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
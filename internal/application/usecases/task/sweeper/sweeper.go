@@ -0,0 +1,64 @@
+package sweeper
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/usecases/task/webhook"
+	"task-processor/internal/domain"
+)
+
+// Sweeper moves FAILED tasks without attempts left into the dead-letter queue
+type Sweeper struct {
+	taskRepo       taskrepo.TaskRepository
+	failedTaskRepo failedtaskrepo.FailedTaskRepository
+	txManager      txmanager.TxManager
+	notifier       *webhook.Notifier
+	batchSize      int
+}
+
+func NewSweeper(
+	taskRepo       taskrepo.TaskRepository,
+	failedTaskRepo failedtaskrepo.FailedTaskRepository,
+	txManager      txmanager.TxManager,
+	notifier       *webhook.Notifier,
+	batchSize      int,
+) *Sweeper {
+	return &Sweeper{
+		taskRepo:       taskRepo,
+		failedTaskRepo: failedTaskRepo,
+		txManager:      txManager,
+		notifier:       notifier,
+		batchSize:      batchSize,
+	}
+}
+
+// SweepExhausted moves up to one batch of exhausted tasks to failed_tasks in a
+// single transaction and reports how many were moved
+func (s *Sweeper) SweepExhausted(ctx context.Context) (int, error) {
+	var moved int
+
+	err := s.txManager.WithTransaction(ctx, func(ctx context.Context) error {
+		tasks, err := s.taskRepo.DeleteExhausted(ctx, s.batchSize)
+		if err != nil {
+			return fmt.Errorf("failed to delete exhausted tasks: %w", err)
+		}
+		for _, task := range tasks {
+			if err := s.failedTaskRepo.Create(ctx, task); err != nil {
+				return fmt.Errorf("failed to create failed task record: %w", err)
+			}
+			if err := s.notifier.Notify(ctx, domain.WebhookTaskDeadLettered, task); err != nil {
+				return err
+			}
+		}
+		moved = len(tasks)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
\ No newline at end of file
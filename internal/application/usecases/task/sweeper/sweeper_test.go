@@ -0,0 +1,87 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/application/usecases/task/webhook"
+	"task-processor/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSweepExhausted_MovesTasks(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockTx := new(txmanager.MockTxManager)
+
+	tasks := []*domain.Task{
+		{ID: uuid.New(), Status: domain.StatusFailed, Attempts: 3, MaxAttempts: 3},
+		{ID: uuid.New(), Status: domain.StatusFailed, Attempts: 3, MaxAttempts: 3},
+	}
+
+	mockTx.On("WithTransaction", ctx, mock.Anything).Return(nil)
+	mockRepo.On("DeleteExhausted", ctx, 50).Return(tasks, nil)
+	mockFailedRepo.On("Create", ctx, tasks[0]).Return(nil)
+	mockFailedRepo.On("Create", ctx, tasks[1]).Return(nil)
+
+	s := NewSweeper(mockRepo, mockFailedRepo, mockTx, webhook.NewNotifier(nil, false, nil), 50)
+	moved, err := s.SweepExhausted(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, moved)
+	mockRepo.AssertExpectations(t)
+	mockFailedRepo.AssertExpectations(t)
+	mockTx.AssertExpectations(t)
+}
+
+func TestSweepExhausted_CreateFails(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockTx := new(txmanager.MockTxManager)
+
+	tasks := []*domain.Task{{ID: uuid.New(), Status: domain.StatusFailed, Attempts: 3, MaxAttempts: 3}}
+
+	mockTx.On("WithTransaction", ctx, mock.Anything).Return(nil)
+	mockRepo.On("DeleteExhausted", ctx, 50).Return(tasks, nil)
+	mockFailedRepo.On("Create", ctx, tasks[0]).Return(errors.New("db error"))
+
+	s := NewSweeper(mockRepo, mockFailedRepo, mockTx, webhook.NewNotifier(nil, false, nil), 50)
+	moved, err := s.SweepExhausted(ctx)
+
+	assert.Error(t, err)
+	assert.Zero(t, moved)
+}
+
+func TestSweepExhausted_QueuesWebhooks(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(taskrepo.MockTaskRepository)
+	mockFailedRepo := new(failedtaskrepo.MockFailedTaskRepo)
+	mockTx := new(txmanager.MockTxManager)
+	mockWebhooks := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	withURL := &domain.Task{ID: uuid.New(), Status: domain.StatusFailed, CallbackURL: "https://example.com/hook"}
+	withoutURL := &domain.Task{ID: uuid.New(), Status: domain.StatusFailed}
+
+	mockTx.On("WithTransaction", ctx, mock.Anything).Return(nil)
+	mockRepo.On("DeleteExhausted", ctx, 50).Return([]*domain.Task{withURL, withoutURL}, nil)
+	mockFailedRepo.On("Create", ctx, mock.Anything).Return(nil)
+	mockWebhooks.On("Create", ctx, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.TaskID == withURL.ID && d.Event == domain.WebhookTaskDeadLettered
+	})).Return(nil).Once()
+
+	s := NewSweeper(mockRepo, mockFailedRepo, mockTx, webhook.NewNotifier(mockWebhooks, true, nil), 50)
+	moved, err := s.SweepExhausted(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, moved)
+	mockWebhooks.AssertExpectations(t)
+}
\ No newline at end of file
@@ -4,13 +4,41 @@ import (
 	"context"
 	"task-processor/internal/application/ports/inbound/random"
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/ports/outbound/outboxsink"
 	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/application/ports/outbound/persistence/processingrunrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
 	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/application/ports/outbound/taskhandler"
+	"task-processor/internal/application/ports/outbound/taskstream"
+	"task-processor/internal/application/ports/outbound/webhooksender"
 	"task-processor/internal/application/usecases/task/acquirer"
+	"task-processor/internal/application/usecases/task/backoff"
 	"task-processor/internal/application/usecases/task/creator"
+	"task-processor/internal/application/usecases/task/deadletter"
+	"task-processor/internal/application/usecases/task/eventpruner"
+	"task-processor/internal/application/usecases/task/history"
+	"task-processor/internal/application/usecases/task/leasekeeper"
+	"task-processor/internal/application/usecases/task/lister"
+	"task-processor/internal/application/usecases/task/outbox"
+	"task-processor/internal/application/usecases/task/outboxrelay"
+	"task-processor/internal/application/usecases/task/processingrun"
+	"task-processor/internal/application/usecases/task/reader"
+	"task-processor/internal/application/usecases/task/reaper"
+	"task-processor/internal/application/usecases/task/rescheduler"
 	"task-processor/internal/application/usecases/task/singleprocessor"
+	"task-processor/internal/application/usecases/task/stats"
+	"task-processor/internal/application/usecases/task/stream"
+	"task-processor/internal/application/usecases/task/sweeper"
+	"task-processor/internal/application/usecases/task/webhook"
+	"task-processor/internal/application/usecases/task/webhookdispatcher"
 	"task-processor/internal/domain"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -18,29 +46,203 @@ import (
 type UseCases struct {
 	Creator   		 Creator
 	Acquirer  	 	 Acquirer
+	Leases           LeaseKeeper
 	SingleProcessor  SingleProcessor
+	Reaper           Reaper
+	Sweeper          Sweeper
+	Rescheduler      Rescheduler
+	Reader           Reader
+	Lister           Lister
+	DeadLetter       DeadLetter
+	Stats            Stats
+	History          History
+	EventPruner      EventPruner
+	WebhookDispatcher WebhookDispatcher
+	OutboxRelay      OutboxRelay
+	ProcessingRuns   ProcessingRuns
+}
+
+// Settings holds the tunables of the task use cases
+type Settings struct {
+	// Lease taken by this instance on every acquired task
+	Lease            domain.Lease
+	// ReleaseTimeout bounds storing an outcome or returning a task to the queue after its processing was cancelled
+	ReleaseTimeout   time.Duration
+	// ReaperBatchSize limits how many expired leases are released per run
+	ReaperBatchSize  int
+	// RetryBackoff delays the next attempt of a failed task
+	RetryBackoff     backoff.Config
+	// SweeperBatchSize limits how many exhausted tasks are moved to the DLQ per run
+	SweeperBatchSize int
+	// StatsCacheTTL is how long a queue statistics snapshot is reused, zero disables caching
+	StatsCacheTTL    time.Duration
+	// EventRetention is how long task events are kept, zero keeps them forever
+	EventRetention   time.Duration
+	// EventPruneBatchSize limits how many expired task events are deleted per run
+	EventPruneBatchSize int
+	// Webhooks configures completion notifications sent to callback URLs
+	Webhooks         WebhookSettings
+	// Outbox configures publishing of task lifecycle events
+	Outbox           OutboxSettings
+	// Runs configures background processing runs
+	Runs             RunSettings
+}
+
+// WebhookSettings holds the tunables of completion notifications
+type WebhookSettings struct {
+	// Enabled turns queuing of notifications on
+	Enabled      bool
+	// TypeURLs are the callback URLs of tasks created without one, by task type
+	TypeURLs     map[string]string
+	// BatchSize limits how many deliveries are sent per run
+	BatchSize    int
+	// MaxAttempts is how many times a delivery is tried before it is abandoned
+	MaxAttempts  int
+	// ClaimLease is how long a claimed delivery is reserved for the sending instance
+	ClaimLease   time.Duration
+	// RetryBackoff delays the next attempt of a failed delivery
+	RetryBackoff backoff.Config
+}
+
+// OutboxSettings holds the tunables of lifecycle event publishing
+type OutboxSettings struct {
+	// Enabled turns writing of outbox messages on
+	Enabled    bool
+	// BatchSize limits how many messages are published or pruned per run
+	BatchSize  int
+	// ClaimLease is how long a claimed batch is reserved for the publishing instance
+	ClaimLease time.Duration
+	// Retention is how long published messages are kept
+	Retention  time.Duration
+}
+
+// RunSettings holds the tunables of background processing runs
+type RunSettings struct {
+	// StaleAfter is how long a running run may go without progress before it is failed as orphaned, zero disables it
+	StaleAfter      time.Duration
+	// Retention is how long finished runs are kept, zero keeps them forever
+	Retention       time.Duration
+	// BatchSize limits how many runs are failed or pruned per job run
+	BatchSize       int
 }
 
 func NewUseCases(
 	taskRepo taskrepo.TaskRepository,
 	failedTaskRepo failedtaskrepo.FailedTaskRepository,
+	taskEventRepo  taskeventrepo.TaskEventRepository,
+	taskAttemptRepo taskattemptrepo.TaskAttemptRepository,
+	webhookRepo    webhookrepo.WebhookDeliveryRepository,
+	outboxRepo     outboxrepo.OutboxRepository,
+	processingRunRepo processingrunrepo.ProcessingRunRepository,
 	txManager txmanager.TxManager,
+	locker         locker.Locker,
 	randomProvider random.RandomProvider,
+	handlers 	   taskhandler.Registry,
+	webhookSender  webhooksender.Sender,
+	outboxSink     outboxsink.Sink,
+	streamPublisher taskstream.Publisher,
+	settings       Settings,
 ) *UseCases {
 
-	return &UseCases{
-		Creator:   creator.NewCreator(taskRepo),
-		Acquirer:  acquirer.NewAcquirer(taskRepo),
-		SingleProcessor: singleprocessor.NewSingleProcessor(taskRepo, failedTaskRepo, txManager, randomProvider),
+	notifier := webhook.NewNotifier(webhookRepo, settings.Webhooks.Enabled, settings.Webhooks.TypeURLs)
+	outboxWriter := outbox.NewWriter(outboxRepo, settings.Outbox.Enabled)
+	streamEmitter := stream.NewEmitter(streamPublisher)
+	retryBackoff := backoff.NewPolicy(settings.RetryBackoff, randomProvider)
+
+	useCases := &UseCases{
+		Creator:   creator.NewCreator(taskRepo, txManager, outboxWriter),
+		Acquirer:  acquirer.NewAcquirer(taskRepo, settings.Lease, streamEmitter),
+		Leases:    leasekeeper.NewKeeper(taskRepo, settings.Lease),
+		SingleProcessor: singleprocessor.NewSingleProcessor(
+			taskRepo, failedTaskRepo, taskAttemptRepo, txManager, randomProvider, handlers, settings.Lease, settings.ReleaseTimeout,
+			retryBackoff, notifier, outboxWriter, streamEmitter,
+		),
+		Reaper:    reaper.NewReaper(taskRepo, retryBackoff, settings.ReaperBatchSize),
+		Sweeper:   sweeper.NewSweeper(taskRepo, failedTaskRepo, txManager, notifier, settings.SweeperBatchSize),
+		Rescheduler: rescheduler.NewRescheduler(taskRepo),
+		Reader:      reader.NewReader(taskRepo, failedTaskRepo, taskAttemptRepo),
+		Lister:      lister.NewLister(taskRepo),
+		DeadLetter:  deadletter.NewDeadLetter(taskRepo, failedTaskRepo, txManager),
+		Stats:       stats.NewStats(taskRepo, failedTaskRepo, settings.StatsCacheTTL),
+		History:     history.NewHistory(taskEventRepo, taskRepo, failedTaskRepo),
+		EventPruner: eventpruner.NewPruner(taskEventRepo, settings.EventRetention, settings.EventPruneBatchSize),
+		WebhookDispatcher: webhookdispatcher.NewDispatcher(
+			webhookRepo, webhookSender, backoff.NewPolicy(settings.Webhooks.RetryBackoff, randomProvider),
+			settings.Webhooks.BatchSize, settings.Webhooks.MaxAttempts, settings.Webhooks.ClaimLease,
+		),
+		ProcessingRuns: processingrun.NewTracker(
+			processingRunRepo, settings.Lease.Owner, settings.Runs.StaleAfter, settings.Runs.Retention, settings.Runs.BatchSize,
+		),
 	}
+	// Without the outbox there is no sink to relay to, OutboxRelay stays nil
+	if settings.Outbox.Enabled {
+		useCases.OutboxRelay = outboxrelay.NewRelay(
+			outboxRepo, outboxSink, txManager, locker,
+			settings.Outbox.BatchSize, settings.Outbox.ClaimLease, settings.Outbox.Retention,
+		)
+	}
+	return useCases
 }
 
 type Creator interface {
-	CreateTasksBatch(ctx context.Context, count int) ([]uuid.UUID, error)
+	CreateTasksBatch(ctx context.Context, request *tasksprocessor.BatchCreateTasksRequest) ([]uuid.UUID, error)
 }
 type Acquirer interface {
 	AcquireTasks(ctx context.Context, limit int) ([]*domain.Task, error) 
 }
+type LeaseKeeper interface {
+	Hold(ctx context.Context, task *domain.Task) (context.Context, func())
+}
 type SingleProcessor interface {
 	ProcessTask(ctx context.Context, task *domain.Task, request *tasksprocessor.ProcessTasksRequest) (bool, error)
 }
+type Reaper interface {
+	ReleaseExpired(ctx context.Context) (int, error)
+}
+type Sweeper interface {
+	SweepExhausted(ctx context.Context) (int, error)
+}
+type Rescheduler interface {
+	Reschedule(ctx context.Context, taskID uuid.UUID, request *tasksprocessor.RescheduleTaskRequest) (time.Time, error)
+}
+type Reader interface {
+	GetTask(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	GetAttempts(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskAttempt, error)
+}
+type Lister interface {
+	ListTasks(ctx context.Context, request *tasksprocessor.ListTasksRequest) (*tasksprocessor.TaskPage, error)
+}
+type DeadLetter interface {
+	ListTasks(ctx context.Context, request *tasksprocessor.ListDeadLetterRequest) (*tasksprocessor.TaskPage, error)
+	GetTask(ctx context.Context, taskID uuid.UUID) (*domain.Task, error)
+	Requeue(ctx context.Context, taskID uuid.UUID) error
+	RequeueMatching(ctx context.Context, request *tasksprocessor.DeadLetterBulkRequest) (int, error)
+	Purge(ctx context.Context, taskID uuid.UUID) error
+	PurgeMatching(ctx context.Context, request *tasksprocessor.DeadLetterBulkRequest) (int, error)
+}
+type Stats interface {
+	GetStats(ctx context.Context) (*domain.QueueStats, error)
+}
+type History interface {
+	GetHistory(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskEvent, error)
+}
+type EventPruner interface {
+	PruneExpired(ctx context.Context) (int, error)
+}
+type WebhookDispatcher interface {
+	DeliverDue(ctx context.Context) (int, error)
+}
+type OutboxRelay interface {
+	PublishPending(ctx context.Context) (int, error)
+	PrunePublished(ctx context.Context) (int, error)
+}
+type ProcessingRuns interface {
+	Start(ctx context.Context, request *tasksprocessor.ProcessTasksRequest) (*domain.ProcessingRun, error)
+	Acquired(ctx context.Context, runID uuid.UUID, count int) error
+	Record(ctx context.Context, runID uuid.UUID, outcome tasksprocessor.TaskOutcomeKind) error
+	Finish(ctx context.Context, runID uuid.UUID, runErr error) error
+	Get(ctx context.Context, runID uuid.UUID) (*domain.ProcessingRun, error)
+	KeepAlive(ctx context.Context, runID uuid.UUID) func()
+	FailOrphaned(ctx context.Context) (int, error)
+	PruneFinished(ctx context.Context) (int, error)
+}
\ No newline at end of file
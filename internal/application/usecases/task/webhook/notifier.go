@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payload is the JSON body delivered to callback URLs
+type Payload struct {
+	Event        domain.WebhookEvent `json:"event"`
+	TaskID       uuid.UUID           `json:"task_id"`
+	Type         string              `json:"type"`
+	Status       domain.TaskStatus   `json:"status"`
+	Attempts     int                 `json:"attempts"`
+	Result       json.RawMessage     `json:"result,omitempty"`
+	ErrorMessage string              `json:"error_message,omitempty"`
+	OccurredAt   time.Time           `json:"occurred_at"`
+}
+
+// Notifier queues completion notifications of finished tasks. Deliveries are
+// written by the caller's transaction, so a notification exists exactly when
+// the state change it describes was committed.
+type Notifier struct {
+	repo     webhookrepo.WebhookDeliveryRepository
+	enabled  bool
+	// typeURLs are the callback URLs of tasks created without one
+	typeURLs map[string]string
+	now      func() time.Time
+}
+
+func NewNotifier(
+	repo     webhookrepo.WebhookDeliveryRepository,
+	enabled  bool,
+	typeURLs map[string]string,
+) *Notifier {
+	return &Notifier{
+		repo:     repo,
+		enabled:  enabled,
+		typeURLs: typeURLs,
+		now:      time.Now,
+	}
+}
+
+// Notify queues a delivery of event for the task, if a callback URL applies to it
+func (n *Notifier) Notify(ctx context.Context, event domain.WebhookEvent, task *domain.Task) error {
+	url := n.callbackURL(task)
+	if url == "" {
+		return nil
+	}
+
+	payload, err := json.Marshal(Payload{
+		Event:        event,
+		TaskID:       task.ID,
+		Type:         task.Type,
+		Status:       task.Status,
+		Attempts:     task.Attempts,
+		Result:       task.Result,
+		ErrorMessage: task.ErrorMessage,
+		OccurredAt:   n.now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	err = n.repo.Create(ctx, &domain.WebhookDelivery{
+		TaskID:  task.ID,
+		Event:   event,
+		URL:     url,
+		Payload: payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue webhook: %w", err)
+	}
+	return nil
+}
+
+func (n *Notifier) callbackURL(task *domain.Task) string {
+	if !n.enabled {
+		return ""
+	}
+	if task.CallbackURL != "" {
+		return task.CallbackURL
+	}
+	return n.typeURLs[task.Type]
+}
\ No newline at end of file
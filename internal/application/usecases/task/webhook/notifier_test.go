@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotify_TaskCallbackURL(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	now := time.Date(2025, 5, 1, 8, 30, 0, 0, time.UTC)
+	task := &domain.Task{
+		ID:          uuid.New(),
+		Type:        "email",
+		Status:      domain.StatusProcessed,
+		Attempts:    2,
+		Result:      json.RawMessage(`{"sent":true}`),
+		CallbackURL: "https://example.com/hook",
+	}
+
+	var queued *domain.WebhookDelivery
+	mockRepo.On("Create", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		queued = args.Get(1).(*domain.WebhookDelivery)
+	}).Return(nil)
+
+	notifier := NewNotifier(mockRepo, true, map[string]string{"email": "https://example.com/default"})
+	notifier.now = func() time.Time { return now }
+
+	require.NoError(t, notifier.Notify(ctx, domain.WebhookTaskProcessed, task))
+
+	require.NotNil(t, queued)
+	assert.Equal(t, task.ID, queued.TaskID)
+	assert.Equal(t, domain.WebhookTaskProcessed, queued.Event)
+	assert.Equal(t, "https://example.com/hook", queued.URL)
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(queued.Payload, &payload))
+	assert.Equal(t, Payload{
+		Event:      domain.WebhookTaskProcessed,
+		TaskID:     task.ID,
+		Type:       "email",
+		Status:     domain.StatusProcessed,
+		Attempts:   2,
+		Result:     json.RawMessage(`{"sent":true}`),
+		OccurredAt: now,
+	}, payload)
+}
+
+func TestNotify_FallsBackToTypeURL(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	task := &domain.Task{ID: uuid.New(), Type: "report", Status: domain.StatusFailed, ErrorMessage: "boom"}
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(d *domain.WebhookDelivery) bool {
+		return d.URL == "https://example.com/reports" && d.Event == domain.WebhookTaskDeadLettered
+	})).Return(nil)
+
+	notifier := NewNotifier(mockRepo, true, map[string]string{"report": "https://example.com/reports"})
+
+	require.NoError(t, notifier.Notify(ctx, domain.WebhookTaskDeadLettered, task))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestNotify_NoURLOrDisabled(t *testing.T) {
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	withoutURL := &domain.Task{ID: uuid.New(), Type: "email"}
+	withURL := &domain.Task{ID: uuid.New(), Type: "email", CallbackURL: "https://example.com/hook"}
+
+	require.NoError(t, NewNotifier(mockRepo, true, nil).Notify(context.Background(), domain.WebhookTaskProcessed, withoutURL))
+	require.NoError(t, NewNotifier(mockRepo, false, nil).Notify(context.Background(), domain.WebhookTaskProcessed, withURL))
+
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestNotify_RepositoryError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	dbErr := errors.New("db down")
+	mockRepo.On("Create", ctx, mock.Anything).Return(dbErr)
+
+	task := &domain.Task{ID: uuid.New(), Type: "email", CallbackURL: "https://example.com/hook"}
+	err := NewNotifier(mockRepo, true, nil).Notify(ctx, domain.WebhookTaskProcessed, task)
+
+	assert.ErrorIs(t, err, dbErr)
+}
\ No newline at end of file
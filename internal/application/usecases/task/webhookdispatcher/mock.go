@@ -0,0 +1,16 @@
+package webhookdispatcher
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockDispatcher struct {
+	mock.Mock
+}
+
+func (m *MockDispatcher) DeliverDue(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
\ No newline at end of file
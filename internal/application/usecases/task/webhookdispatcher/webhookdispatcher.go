@@ -0,0 +1,87 @@
+package webhookdispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/application/ports/outbound/webhooksender"
+	"task-processor/internal/application/usecases/task/backoff"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// Dispatcher delivers queued completion notifications, retrying failures with
+// backoff until the receiver acknowledges them or the attempts run out
+type Dispatcher struct {
+	repo         webhookrepo.WebhookDeliveryRepository
+	sender       webhooksender.Sender
+	retryBackoff *backoff.Policy
+	batchSize    int
+	maxAttempts  int
+	// claimLease keeps a claimed delivery from being sent by another instance
+	claimLease   time.Duration
+}
+
+func NewDispatcher(
+	repo         webhookrepo.WebhookDeliveryRepository,
+	sender       webhooksender.Sender,
+	retryBackoff *backoff.Policy,
+	batchSize    int,
+	maxAttempts  int,
+	claimLease   time.Duration,
+) *Dispatcher {
+	return &Dispatcher{
+		repo:         repo,
+		sender:       sender,
+		retryBackoff: retryBackoff,
+		batchSize:    batchSize,
+		maxAttempts:  maxAttempts,
+		claimLease:   claimLease,
+	}
+}
+
+// DeliverDue sends up to one batch of due deliveries and reports how many were acknowledged
+func (d *Dispatcher) DeliverDue(ctx context.Context) (int, error) {
+	deliveries, err := d.repo.ClaimDue(ctx, d.batchSize, d.claimLease)
+	if err != nil {
+		return 0, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+
+	var delivered int
+	var errs []error
+	for _, delivery := range deliveries {
+		// Unsent claims become due again once their lease passes
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+
+		ok, err := d.deliver(ctx, delivery)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("delivery %d: %w", delivery.ID, err))
+		}
+		if ok {
+			delivered++
+		}
+	}
+
+	return delivered, errors.Join(errs...)
+}
+
+// deliver sends one delivery and records the outcome
+func (d *Dispatcher) deliver(ctx context.Context, delivery *domain.WebhookDelivery) (bool, error) {
+	statusCode, err := d.sender.Send(ctx, delivery)
+	if err == nil && statusCode >= 200 && statusCode < 300 {
+		return true, d.repo.MarkDelivered(ctx, delivery.ID, statusCode)
+	}
+
+	message := fmt.Sprintf("unexpected status %d", statusCode)
+	if err != nil {
+		message = err.Error()
+	}
+
+	if delivery.Attempts >= d.maxAttempts {
+		return false, d.repo.MarkAbandoned(ctx, delivery.ID, statusCode, message)
+	}
+	return false, d.repo.MarkFailed(ctx, delivery.ID, statusCode, message, d.retryBackoff.Delay(delivery.Attempts))
+}
\ No newline at end of file
@@ -0,0 +1,135 @@
+package webhookdispatcher
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/application/ports/outbound/webhooksender"
+	"task-processor/internal/application/usecases/task/backoff"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBatchSize   = 10
+	testMaxAttempts = 3
+	testClaimLease  = time.Minute
+)
+
+var testBackoff = backoff.NewPolicy(backoff.Config{
+	Base:       time.Second,
+	Multiplier: 2,
+	Cap:        time.Minute,
+	Jitter:     backoff.JitterNone,
+}, nil)
+
+func newDelivery(id int64, attempts int) *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:       id,
+		TaskID:   uuid.New(),
+		Event:    domain.WebhookTaskProcessed,
+		URL:      "https://example.com/hook",
+		Payload:  []byte(`{}`),
+		Status:   domain.DeliveryPending,
+		Attempts: attempts,
+	}
+}
+
+func TestDeliverDue_Acknowledged(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+	mockSender := new(webhooksender.MockSender)
+
+	delivery := newDelivery(1, 1)
+	mockRepo.On("ClaimDue", ctx, testBatchSize, testClaimLease).Return([]*domain.WebhookDelivery{delivery}, nil)
+	mockSender.On("Send", ctx, delivery).Return(204, nil)
+	mockRepo.On("MarkDelivered", ctx, int64(1), 204).Return(nil)
+
+	dispatcher := NewDispatcher(mockRepo, mockSender, testBackoff, testBatchSize, testMaxAttempts, testClaimLease)
+	delivered, err := dispatcher.DeliverDue(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	mockRepo.AssertExpectations(t)
+	mockSender.AssertExpectations(t)
+}
+
+func TestDeliverDue_RetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+	mockSender := new(webhooksender.MockSender)
+
+	rejected := newDelivery(1, 1)
+	unreachable := newDelivery(2, 2)
+	mockRepo.On("ClaimDue", ctx, testBatchSize, testClaimLease).
+		Return([]*domain.WebhookDelivery{rejected, unreachable}, nil)
+	mockSender.On("Send", ctx, rejected).Return(503, nil)
+	mockSender.On("Send", ctx, unreachable).Return(0, errors.New("connection refused"))
+	mockRepo.On("MarkFailed", ctx, int64(1), 503, "unexpected status 503", time.Second).Return(nil)
+	mockRepo.On("MarkFailed", ctx, int64(2), 0, "connection refused", 2*time.Second).Return(nil)
+
+	dispatcher := NewDispatcher(mockRepo, mockSender, testBackoff, testBatchSize, testMaxAttempts, testClaimLease)
+	delivered, err := dispatcher.DeliverDue(ctx)
+
+	require.NoError(t, err)
+	assert.Zero(t, delivered)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeliverDue_AbandonsAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+	mockSender := new(webhooksender.MockSender)
+
+	delivery := newDelivery(7, testMaxAttempts)
+	mockRepo.On("ClaimDue", ctx, testBatchSize, testClaimLease).Return([]*domain.WebhookDelivery{delivery}, nil)
+	mockSender.On("Send", ctx, delivery).Return(410, nil)
+	mockRepo.On("MarkAbandoned", ctx, int64(7), 410, "unexpected status 410").Return(nil)
+
+	dispatcher := NewDispatcher(mockRepo, mockSender, testBackoff, testBatchSize, testMaxAttempts, testClaimLease)
+	_, err := dispatcher.DeliverDue(ctx)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "MarkFailed")
+}
+
+func TestDeliverDue_ContinuesAfterRecordingError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+	mockSender := new(webhooksender.MockSender)
+
+	first := newDelivery(1, 1)
+	second := newDelivery(2, 1)
+	dbErr := errors.New("db down")
+	mockRepo.On("ClaimDue", ctx, testBatchSize, testClaimLease).Return([]*domain.WebhookDelivery{first, second}, nil)
+	mockSender.On("Send", ctx, first).Return(200, nil)
+	mockSender.On("Send", ctx, second).Return(200, nil)
+	mockRepo.On("MarkDelivered", ctx, int64(1), 200).Return(dbErr)
+	mockRepo.On("MarkDelivered", ctx, int64(2), 200).Return(nil)
+
+	dispatcher := NewDispatcher(mockRepo, mockSender, testBackoff, testBatchSize, testMaxAttempts, testClaimLease)
+	delivered, err := dispatcher.DeliverDue(ctx)
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Equal(t, 2, delivered)
+	mockSender.AssertExpectations(t)
+}
+
+func TestDeliverDue_ClaimError(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(webhookrepo.MockWebhookDeliveryRepository)
+
+	dbErr := errors.New("db down")
+	mockRepo.On("ClaimDue", ctx, testBatchSize, testClaimLease).Return([]*domain.WebhookDelivery(nil), dbErr)
+
+	dispatcher := NewDispatcher(mockRepo, nil, testBackoff, testBatchSize, testMaxAttempts, testClaimLease)
+	_, err := dispatcher.DeliverDue(ctx)
+
+	assert.ErrorIs(t, err, dbErr)
+}
\ No newline at end of file
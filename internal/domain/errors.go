@@ -0,0 +1,40 @@
+package domain
+
+import "errors"
+
+// ErrTaskNotFound is returned when no task exists with the requested ID
+var ErrTaskNotFound = errors.New("task not found")
+
+// ErrTaskNotPending is returned when an operation requires a task that
+// has not started processing yet (NEW, or FAILED and waiting for a retry)
+var ErrTaskNotPending = errors.New("task is not pending")
+
+// ErrLeaseLost is returned when the task is no longer leased by the caller
+// (the lease expired and the task was reclaimed or finished elsewhere)
+var ErrLeaseLost = errors.New("task lease lost")
+
+// ErrScheduleNotFound is returned when no schedule exists with the requested ID
+var ErrScheduleNotFound = errors.New("schedule not found")
+
+// ErrScheduleNameTaken is returned when another schedule already uses the name
+var ErrScheduleNameTaken = errors.New("schedule name already taken")
+
+// ErrInvalidSchedule is returned when a schedule definition cannot be used
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrTaskReleased is returned when processing was cancelled and the task went
+// back to the queue without the attempt being counted
+var ErrTaskReleased = errors.New("task released back to the queue")
+
+// ErrRunNotFound is returned when no processing run exists with the requested ID
+var ErrRunNotFound = errors.New("processing run not found")
+
+// ErrRunFinished is returned when a processing run is no longer running, for
+// instance because it was failed as orphaned before its instance finished it
+var ErrRunFinished = errors.New("processing run already finished")
+
+// ErrShuttingDown is returned when work is refused because the instance is shutting down
+var ErrShuttingDown = errors.New("shutting down")
\ No newline at end of file
@@ -0,0 +1,12 @@
+package domain
+
+import "time"
+
+// Lease describes the claim an instance holds on the tasks it is processing
+type Lease struct {
+	// Identifier of the instance holding the lease
+	Owner    string
+
+	// How long the claim lasts unless it is extended
+	Duration time.Duration
+}
\ No newline at end of file
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxMessage is a task lifecycle event waiting to be published to other systems
+type OutboxMessage struct {
+    // Sequence number, publishing in this order keeps the events of a task ordered
+    ID                  int64
+
+    // Task the event belongs to
+    TaskID              uuid.UUID
+
+    // What happened
+    Event               TaskEventType
+
+    // JSON document describing the task after the change
+    Payload             json.RawMessage
+
+    // When the message was written
+    CreatedAt           time.Time
+}
\ No newline at end of file
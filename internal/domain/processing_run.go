@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RunStatus string
+
+const (
+	RunRunning   RunStatus = "RUNNING"
+	RunCompleted RunStatus = "COMPLETED"
+	RunFailed    RunStatus = "FAILED"
+)
+
+// RunCounters tally the tasks of a processing run
+type RunCounters struct {
+    // Tasks acquired by the run
+    Acquired            int
+
+    // Tasks attempted, whatever the outcome
+    Processed           int
+
+    // Tasks processed successfully
+    Succeeded           int
+
+    // Tasks failed by their handler
+    Failed              int
+
+    // Tasks that hit an infrastructure error
+    Errors              int
+
+    // Tasks returned to the queue without counting the attempt
+    Released            int
+}
+
+// ProcessingRun is a batch of tasks processed in the background
+type ProcessingRun struct {
+    ID                  uuid.UUID
+
+    // RUNNING until every acquired task has an outcome
+    Status              RunStatus
+
+    // Instance executing the run
+    Owner               string
+
+    // Processing parameters the run was started with
+    Limit               int
+    MinDelayMS          int
+    MaxDelayMS          int
+
+    // Progress so far, final once the run has finished
+    Counters            RunCounters
+
+    // Why the run failed, empty otherwise
+    ErrorMessage        string
+
+    CreatedAt           time.Time
+    UpdatedAt           time.Time
+
+    // When the run finished, nil while it is running
+    FinishedAt          *time.Time
+}
\ No newline at end of file
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MisfirePolicy string
+
+const (
+	// MisfireSkip fires a late schedule once and drops the other missed ticks
+	MisfireSkip    MisfirePolicy = "skip"
+	// MisfireCatchUp fires once for every missed tick (bounded by the scheduler)
+	MisfireCatchUp MisfirePolicy = "catch_up"
+)
+
+// ScheduledAtPlaceholder is replaced in the payload template with the tick time (RFC 3339)
+const ScheduledAtPlaceholder = "{{scheduled_at}}"
+
+type Schedule struct {
+    // Unique identifier for the schedule
+    ID                  uuid.UUID
+
+    // Human readable unique name
+    Name                string
+
+    // Cron expression (5 fields or a descriptor such as @hourly), empty when Interval is used
+    CronExpr            string
+
+    // Fixed interval between runs, zero when CronExpr is used
+    Interval            time.Duration
+
+    // Type of the tasks enqueued on every run
+    TaskType            string
+
+    // JSON template copied into every enqueued task
+    Payload             json.RawMessage
+
+    // Priority of the enqueued tasks
+    Priority            int
+
+    // What to do with ticks missed while no scheduler was running
+    MisfirePolicy       MisfirePolicy
+
+    // Disabled schedules keep their state but never fire
+    Enabled             bool
+
+    // Next tick the schedule is due at
+    NextRunAt           time.Time
+
+    // Last tick tasks were enqueued for
+    LastRunAt           *time.Time
+
+    CreatedAt           time.Time
+    UpdatedAt           time.Time
+}
\ No newline at end of file
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StreamEvent is a task lifecycle event pushed to live subscribers. Unlike a
+// TaskEvent it is not stored, its ID only orders the events of one instance.
+type StreamEvent struct {
+    // Sequence number assigned when the event is published
+    ID                  uint64
+
+    // What happened
+    Type                TaskEventType
+
+    // Task the event belongs to
+    TaskID              uuid.UUID
+
+    // Type of the task
+    TaskType            string
+
+    // Status of the task after the event
+    Status              TaskStatus
+
+    // Attempt number at the time of the event
+    Attempt             int
+
+    // Error message for failures, empty otherwise
+    Message             string
+
+    // When the event happened
+    OccurredAt          time.Time
+}
\ No newline at end of file
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AttemptOutcome string
+
+const (
+	AttemptSucceeded   AttemptOutcome = "succeeded"
+	AttemptFailed      AttemptOutcome = "failed"
+	AttemptLeaseLost   AttemptOutcome = "lease_lost"
+	AttemptInterrupted AttemptOutcome = "interrupted"
+)
+
+// TaskAttempt records a single run of a task handler
+type TaskAttempt struct {
+    // Sequence number, increasing in the order attempts were recorded
+    ID                  int64
+
+    // Task the attempt belongs to
+    TaskID              uuid.UUID
+
+    // Attempt number, starting at 1
+    Number              int
+
+    // Instance that ran the attempt
+    Owner               string
+
+    // How the attempt ended
+    Outcome             AttemptOutcome
+
+    // Error returned by the handler, empty on success
+    ErrorMessage        string
+
+    // When processing started
+    StartedAt           time.Time
+
+    // When the outcome was known
+    FinishedAt          time.Time
+}
+
+// Duration is how long the attempt ran
+func (a *TaskAttempt) Duration() time.Duration {
+	return a.FinishedAt.Sub(a.StartedAt)
+}
\ No newline at end of file
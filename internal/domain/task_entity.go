@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,6 +20,18 @@ type Task struct {
     // Unique identifier for the task (UUID for distributed systems)
     ID                  uuid.UUID   
     
+    // Kind of work the task represents (used to route it to a handler)
+    Type                string
+
+    // Arbitrary JSON document with the input data for the task
+    Payload             json.RawMessage
+
+    // JSON document returned by the handler after successful processing
+    Result              json.RawMessage
+
+    // Higher values are acquired first (aged by waiting time to avoid starvation)
+    Priority            int
+
     // Current state of the task (NEW, PROCESSING, PROCESSED, FAILED)
     Status              TaskStatus  
     
@@ -36,4 +49,22 @@ type Task struct {
 
     // Last error which happened.
     ErrorMessage        string
+
+    // Instance currently holding the task (set while PROCESSING)
+    LockedBy            string
+
+    // When the lease expires and the task may be reclaimed (set while PROCESSING)
+    LockedUntil         *time.Time
+
+    // Earliest time the task may be acquired (pushed back by retry backoff)
+    NextAttemptAt       time.Time
+
+    // Time the task was scheduled for at creation or reschedule (nil means immediately)
+    RunAt               *time.Time
+
+    // When the task was moved to the dead-letter queue (nil while still queued)
+    MovedAt             *time.Time
+
+    // URL notified when the task is processed or dead-lettered (empty uses the type default)
+    CallbackURL         string
 }
\ No newline at end of file
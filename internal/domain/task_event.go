@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskEventType string
+
+const (
+	EventCreated       TaskEventType = "created"
+	EventAcquired      TaskEventType = "acquired"
+	EventProcessed     TaskEventType = "processed"
+	EventFailed        TaskEventType = "failed"
+	EventLeaseExpired  TaskEventType = "lease_expired"
+	EventRescheduled   TaskEventType = "rescheduled"
+	EventDeadLettered  TaskEventType = "dead_lettered"
+	EventRequeued      TaskEventType = "requeued"
+	EventReleased      TaskEventType = "released"
+)
+
+// TaskEvent records one state transition of a task
+type TaskEvent struct {
+    // Sequence number, increasing in the order events were written
+    ID                  int64
+
+    // Task the event belongs to
+    TaskID              uuid.UUID
+
+    // What happened
+    Type                TaskEventType
+
+    // Attempt number at the time of the event
+    Attempt             int
+
+    // Error message for failures, empty otherwise
+    Message             string
+
+    // Instance holding the lease, set for acquisitions
+    Owner               string
+
+    // When the transition happened
+    CreatedAt           time.Time
+}
\ No newline at end of file
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskCursor is the keyset position of a task in listings ordered by (created_at, id) descending
+type TaskCursor struct {
+    CreatedAt           time.Time
+    ID                  uuid.UUID
+}
+
+// TaskFilter narrows a task listing. Zero values mean "no restriction".
+type TaskFilter struct {
+    // Tasks in any of these statuses
+    Statuses            []TaskStatus
+
+    // Tasks of this type
+    Type                string
+
+    // Tasks created at or after this time
+    CreatedFrom         *time.Time
+
+    // Tasks created strictly before this time
+    CreatedTo           *time.Time
+
+    // Tasks whose last error contains this text (case-insensitive)
+    ErrorContains       string
+
+    // Tasks listed after this position
+    After               *TaskCursor
+
+    // Maximum number of tasks returned
+    Limit               int
+}
\ No newline at end of file
@@ -0,0 +1,34 @@
+package domain
+
+import "time"
+
+// QueueStats is a point-in-time snapshot of the queue depth
+type QueueStats struct {
+    // Number of tasks in each status, statuses without tasks are absent
+    ByStatus            map[TaskStatus]int
+
+    // FAILED tasks without attempts left, waiting for the sweeper
+    Exhausted           int
+
+    // Tasks in the dead-letter queue
+    DeadLettered        int
+
+    // Creation time of the oldest NEW task, nil when there are none
+    OldestNewAt         *time.Time
+
+    // When the snapshot was taken
+    CollectedAt         time.Time
+}
+
+// Count returns the number of tasks in status
+func (s *QueueStats) Count(status TaskStatus) int {
+    return s.ByStatus[status]
+}
+
+// OldestNewAge returns how long the oldest NEW task had waited when the snapshot was taken
+func (s *QueueStats) OldestNewAge() time.Duration {
+    if s.OldestNewAt == nil {
+        return 0
+    }
+    return s.CollectedAt.Sub(*s.OldestNewAt)
+}
\ No newline at end of file
@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookTaskProcessed    WebhookEvent = "task.processed"
+	WebhookTaskDeadLettered WebhookEvent = "task.dead_lettered"
+)
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending   DeliveryStatus = "pending"
+	DeliveryDelivered DeliveryStatus = "delivered"
+	DeliveryAbandoned DeliveryStatus = "abandoned"
+)
+
+// WebhookDelivery is a completion notification queued for a task's callback URL
+type WebhookDelivery struct {
+    // Sequence number of the delivery
+    ID                  int64
+
+    // Task the notification is about
+    TaskID              uuid.UUID
+
+    // What happened to the task
+    Event               WebhookEvent
+
+    // Receiver of the notification
+    URL                 string
+
+    // JSON body sent to the receiver, built when the task finished
+    Payload             json.RawMessage
+
+    // Whether the delivery is still being retried
+    Status              DeliveryStatus
+
+    // Delivery attempts made so far
+    Attempts            int
+
+    // HTTP status of the last response (0 when none was received)
+    LastStatusCode      int
+
+    // Why the last attempt failed
+    LastError           string
+
+    // Earliest time of the next attempt
+    NextAttemptAt       time.Time
+
+    // When the delivery was queued
+    CreatedAt           time.Time
+
+    // When the receiver acknowledged the notification
+    DeliveredAt         *time.Time
+}
\ No newline at end of file
@@ -15,9 +15,79 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/tasks/batch-create": {
+        "/api/v1/failed-tasks": {
+            "get": {
+                "description": "Returns tasks from the dead-letter queue newest first, filtered and paginated with a keyset cursor",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Failed tasks"
+                ],
+                "summary": "List dead-lettered tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC 3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text contained in the last error",
+                        "name": "error",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-500), defaults to 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/failed-tasks/purge": {
             "post": {
-                "description": "Creates multiple tasks in a single operation",
+                "description": "Permanently deletes up to limit matching tasks (oldest first) from the dead-letter queue",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,17 +95,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Failed tasks"
                 ],
-                "summary": "Batch create tasks",
+                "summary": "Purge dead-lettered tasks by filter",
                 "parameters": [
                     {
-                        "description": "Tasks to create",
+                        "description": "Filter and limit",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.BatchCreateTasksRequest"
+                            "$ref": "#/definitions/dto.FailedTasksBulkRequest"
                         }
                     }
                 ],
@@ -43,7 +113,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.BatchCreateTasksResponse"
+                            "$ref": "#/definitions/dto.FailedTasksBulkResponse"
                         }
                     },
                     "400": {
@@ -61,9 +131,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/tasks/process": {
+        "/api/v1/failed-tasks/requeue": {
             "post": {
-                "description": "Acquires and processes tasks with configurable parameters",
+                "description": "Moves up to limit matching tasks (oldest first) back to the queue in one transaction",
                 "consumes": [
                     "application/json"
                 ],
@@ -71,17 +141,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Tasks"
+                    "Failed tasks"
                 ],
-                "summary": "Process multiple tasks",
+                "summary": "Requeue dead-lettered tasks by filter",
                 "parameters": [
                     {
-                        "description": "Processing parameters",
+                        "description": "Filter and limit",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ProcessTasksRequest"
+                            "$ref": "#/definitions/dto.FailedTasksBulkRequest"
                         }
                     }
                 ],
@@ -89,7 +159,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ProcessTasksResponse"
+                            "$ref": "#/definitions/dto.FailedTasksBulkResponse"
                         }
                     },
                     "400": {
@@ -107,135 +177,1545 @@ const docTemplate = `{
                 }
             }
         },
-        "/health/live": {
+        "/api/v1/failed-tasks/{id}": {
             "get": {
-                "description": "Returns 200 OK if the service is alive, 503 if shutting down",
+                "description": "Returns a task from the dead-letter queue",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Failed tasks"
+                ],
+                "summary": "Get a dead-lettered task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Liveness check",
                 "responses": {
                     "200": {
-                        "description": "ok",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.TaskResponse"
                         }
                     },
-                    "503": {
-                        "description": "shutting down",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Permanently deletes a task from the dead-letter queue",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Failed tasks"
+                ],
+                "summary": "Purge a dead-lettered task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
                         }
                     }
                 }
             }
         },
-        "/health/ready": {
-            "get": {
-                "description": "Returns 200 OK if ready, 503 if shutting down or not ready",
+        "/api/v1/failed-tasks/{id}/requeue": {
+            "post": {
+                "description": "Moves a task back to the queue as NEW with attempts reset, keeping its ID",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Failed tasks"
+                ],
+                "summary": "Requeue a dead-lettered task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Readiness check",
                 "responses": {
-                    "200": {
-                        "description": "ready",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/utils.HTTPResponse"
                         }
                     },
-                    "503": {
-                        "description": "shutting down / not ready",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.BatchCreateTasksRequest": {
-            "description": "Request payload for batch task creation",
-            "type": "object",
-            "required": [
-                "count"
-            ],
-            "properties": {
-                "count": {
-                    "description": "@Description Number of tasks to create\n@Example     5",
-                    "type": "integer",
-                    "maximum": 50,
-                    "minimum": 1
+        },
+        "/api/v1/processing-runs/{id}": {
+            "get": {
+                "description": "Returns the progress of a background processing run, or its final counters once finished",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get a processing run",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Run ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProcessingRunResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
                 }
             }
         },
-        "dto.BatchCreateTasksResponse": {
-            "description": "Response payload for batch task creation",
-            "type": "object",
-            "properties": {
-                "ids": {
-                    "description": "@Description List of created task IDs",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+        "/api/v1/schedules": {
+            "get": {
+                "description": "Returns all recurring schedules ordered by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "List schedules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ScheduleResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Creates a recurring schedule that enqueues tasks on a cron expression or a fixed interval",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "Create a schedule",
+                "parameters": [
+                    {
+                        "description": "Schedule definition",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
                     }
                 }
             }
         },
-        "dto.ProcessTasksRequest": {
-            "description": "Request payload for task processing",
-            "type": "object",
-            "required": [
-                "limit",
-                "success_rate"
+        "/api/v1/schedules/{id}": {
+            "get": {
+                "description": "Returns a recurring schedule by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "Get a schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replaces the definition of a schedule, the next run is recomputed from now",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "Replace a schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule definition",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a schedule, tasks it already enqueued are kept",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedules"
+                ],
+                "summary": "Delete a schedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks": {
+            "get": {
+                "description": "Returns tasks newest first, filtered and paginated with a keyset cursor",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "List tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated statuses, e.g. NEW,FAILED",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created at or after (RFC 3339)",
+                        "name": "created_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created before (RFC 3339)",
+                        "name": "created_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Text contained in the last error",
+                        "name": "error",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-500), defaults to 50",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/batch-create": {
+            "post": {
+                "description": "Creates multiple tasks in a single operation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Batch create tasks",
+                "parameters": [
+                    {
+                        "description": "Tasks to create",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchCreateTasksRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BatchCreateTasksResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/events/stream": {
+            "get": {
+                "description": "Pushes acquired, processed, failed, dead_lettered and released events as Server-Sent Events, with a comment ping while idle. A client reconnecting with Last-Event-ID gets the events it missed, as long as they are still in the buffer of this instance.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Stream task lifecycle events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma-separated task types",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated task statuses after the event",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.StreamEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/process": {
+            "post": {
+                "description": "Acquires and processes tasks with configurable parameters. With async the batch runs in the background and the response points to the run to poll.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Process multiple tasks",
+                "parameters": [
+                    {
+                        "description": "Processing parameters",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProcessTasksRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProcessTasksResponse"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProcessingRunResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/stats": {
+            "get": {
+                "description": "Returns task counts per status, exhausted and dead-lettered tasks and the age of the oldest NEW task.\nSnapshots are cached briefly, so the endpoint is cheap to poll.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Queue statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.QueueStatsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}": {
+            "get": {
+                "description": "Returns the current state of a task, including tasks moved to the dead-letter queue, with the history of its attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Get a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/events": {
+            "get": {
+                "description": "Returns the state transitions of a task, oldest first. Events older than the retention period are pruned.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Task history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TaskEventsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/tasks/{id}/reschedule": {
+            "post": {
+                "description": "Moves a task that has not started processing to a new due time",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tasks"
+                ],
+                "summary": "Reschedule a pending task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New due time",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RescheduleTaskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RescheduleTaskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.HTTPResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/live": {
+            "get": {
+                "description": "Returns 200 OK if the service is alive, 503 if shutting down",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness check",
+                "responses": {
+                    "200": {
+                        "description": "ok",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "shutting down",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/health/ready": {
+            "get": {
+                "description": "Returns 200 OK if ready, 503 if shutting down or not ready",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness check",
+                "responses": {
+                    "200": {
+                        "description": "ready",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "shutting down / not ready",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.BatchCreateTasksRequest": {
+            "description": "Request payload for batch task creation",
+            "type": "object",
+            "required": [
+                "tasks"
+            ],
+            "properties": {
+                "tasks": {
+                    "description": "@Description Tasks to create (1-50)",
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskInput"
+                    }
+                }
+            }
+        },
+        "dto.BatchCreateTasksResponse": {
+            "description": "Response payload for batch task creation",
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "description": "@Description List of created task IDs",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "dto.FailedTasksBulkRequest": {
+            "description": "Request payload for bulk requeue or purge of dead-lettered tasks",
+            "type": "object",
+            "required": [
+                "limit"
             ],
             "properties": {
+                "created_from": {
+                    "description": "@Description Only tasks created at or after this time (RFC 3339)",
+                    "type": "string"
+                },
+                "created_to": {
+                    "description": "@Description Only tasks created before this time (RFC 3339)",
+                    "type": "string"
+                },
+                "error": {
+                    "description": "@Description Only tasks whose last error contains this text (case-insensitive)\n@Example     timeout",
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "limit": {
+                    "description": "@Description Maximum number of tasks affected (1-1000), oldest first\n@Example     100",
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 1
+                },
+                "type": {
+                    "description": "@Description Only tasks of this type\n@Example     simulated",
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.FailedTasksBulkResponse": {
+            "description": "Result of a bulk requeue or purge",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "@Description Number of tasks affected\n@Example     42",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ProcessTasksRequest": {
+            "description": "Request payload for task processing",
+            "type": "object",
+            "properties": {
+                "async": {
+                    "description": "@Description Process in the background and return a run to poll instead of waiting\n@Example     false",
+                    "type": "boolean"
+                },
+                "include_details": {
+                    "description": "@Description Return the outcome of every processed task, not available for async runs\n@Example     false",
+                    "type": "boolean"
+                },
+                "limit": {
+                    "description": "@Description Number of tasks to process (1-50)\n@Example     10",
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 1
+                },
+                "max_delay_ms": {
+                    "description": "@Description Maximum processing delay in milliseconds\n@Example     500",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "min_delay_ms": {
+                    "description": "@Description Minimum processing delay in milliseconds\n@Example     100",
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
+        "dto.ProcessTasksResponse": {
+            "description": "Response after task processing",
+            "type": "object",
+            "properties": {
+                "details": {
+                    "description": "@Description Outcome of every processed task, only with include_details",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskOutcomeResponse"
+                    }
+                },
+                "error_count": {
+                    "description": "@Description Number of tasks that hit an infrastructure error, they are retried once their lease expires\n@Example     0",
+                    "type": "integer"
+                },
+                "failed_count": {
+                    "description": "@Description Number of tasks failed by their handler\n@Example     2",
+                    "type": "integer"
+                },
+                "processed_count": {
+                    "description": "@Description Total number of tasks processed\n@Example     10",
+                    "type": "integer"
+                },
+                "released_count": {
+                    "description": "@Description Number of tasks returned to the queue because the request was cancelled\n@Example     0",
+                    "type": "integer"
+                },
+                "success_count": {
+                    "description": "@Description Number of successfully processed tasks\n@Example     8",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.ProcessingRunResponse": {
+            "description": "Background processing run",
+            "type": "object",
+            "properties": {
+                "acquired_count": {
+                    "description": "@Description Number of tasks acquired so far\n@Example     10",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "@Description Creation time",
+                    "type": "string"
+                },
+                "error_count": {
+                    "description": "@Description Number of tasks that hit an infrastructure error\n@Example     0",
+                    "type": "integer"
+                },
+                "error_message": {
+                    "description": "@Description Why the run failed",
+                    "type": "string"
+                },
+                "failed_count": {
+                    "description": "@Description Number of tasks failed by their handler\n@Example     1",
+                    "type": "integer"
+                },
+                "finished_at": {
+                    "description": "@Description Time the run finished",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Run ID",
+                    "type": "string"
+                },
                 "limit": {
-                    "description": "@Description Number of tasks to process (1-50)\n@Example     10",
+                    "description": "@Description Maximum number of tasks to process\n@Example     10",
+                    "type": "integer"
+                },
+                "processed_count": {
+                    "description": "@Description Number of tasks processed so far\n@Example     8",
+                    "type": "integer"
+                },
+                "released_count": {
+                    "description": "@Description Number of tasks returned to the queue\n@Example     0",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "@Description RUNNING, COMPLETED or FAILED\n@Example     RUNNING",
+                    "type": "string"
+                },
+                "success_count": {
+                    "description": "@Description Number of successfully processed tasks\n@Example     7",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "description": "@Description Last progress update",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.QueueStatsResponse": {
+            "description": "Snapshot of the queue depth, possibly a few seconds old",
+            "type": "object",
+            "properties": {
+                "collected_at": {
+                    "description": "@Description When the snapshot was taken",
+                    "type": "string"
+                },
+                "counts": {
+                    "description": "@Description Number of tasks in each status",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "dead_lettered": {
+                    "description": "@Description Tasks in the dead-letter queue\n@Example     17",
+                    "type": "integer"
+                },
+                "exhausted": {
+                    "description": "@Description FAILED tasks without attempts left, not yet moved to the dead-letter queue\n@Example     2",
+                    "type": "integer"
+                },
+                "oldest_new_age_seconds": {
+                    "description": "@Description Age of the oldest NEW task in seconds, 0 when there are none\n@Example     12.5",
+                    "type": "number"
+                },
+                "oldest_new_created_at": {
+                    "description": "@Description Creation time of the oldest NEW task",
+                    "type": "string"
+                },
+                "processing": {
+                    "description": "@Description Tasks currently being processed\n@Example     4",
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.RescheduleTaskRequest": {
+            "description": "Request payload for rescheduling a pending task",
+            "type": "object",
+            "properties": {
+                "delay_ms": {
+                    "description": "@Description New due time as a delay in milliseconds from now\n@Example     600000",
                     "type": "integer",
-                    "maximum": 50,
-                    "minimum": 1
+                    "minimum": 0
                 },
-                "max_delay_ms": {
-                    "description": "@Description Maximum processing delay in milliseconds\n@Example     500",
+                "run_at": {
+                    "description": "@Description New due time (RFC 3339), required unless delay_ms is set\n@Example     2030-01-01T02:00:00Z",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.RescheduleTaskResponse": {
+            "description": "Response after rescheduling a task",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "@Description Task ID",
+                    "type": "string"
+                },
+                "run_at": {
+                    "description": "@Description New due time",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ScheduleRequest": {
+            "description": "Request payload for creating or replacing a recurring schedule",
+            "type": "object",
+            "required": [
+                "name",
+                "task_type"
+            ],
+            "properties": {
+                "cron_expr": {
+                    "description": "@Description Cron expression (5 fields or a descriptor such as @hourly), required unless interval_ms is set\n@Example     0 3 * * *",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "enabled": {
+                    "description": "@Description Whether the schedule fires, defaults to true\n@Example     true",
+                    "type": "boolean"
+                },
+                "interval_ms": {
+                    "description": "@Description Fixed interval between runs in milliseconds, cannot be combined with cron_expr\n@Example     60000",
                     "type": "integer",
                     "minimum": 0
                 },
-                "min_delay_ms": {
-                    "description": "@Description Minimum processing delay in milliseconds\n@Example     100",
+                "misfire_policy": {
+                    "description": "@Description What to do with missed ticks: skip (default) or catch_up\n@Example     skip",
+                    "type": "string",
+                    "enum": [
+                        "skip",
+                        "catch_up"
+                    ]
+                },
+                "name": {
+                    "description": "@Description Unique schedule name\n@Example     nightly-report",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "payload": {
+                    "description": "@Description Payload copied into every task, {{scheduled_at}} is replaced with the tick time",
+                    "type": "object"
+                },
+                "priority": {
+                    "description": "@Description Priority of the enqueued tasks (0-1000)\n@Example     10",
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "task_type": {
+                    "description": "@Description Type of the enqueued tasks\n@Example     simulated",
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "dto.ScheduleResponse": {
+            "description": "Recurring schedule",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "description": "@Description Creation time",
+                    "type": "string"
+                },
+                "cron_expr": {
+                    "description": "@Description Cron expression, empty for interval schedules\n@Example     0 3 * * *",
+                    "type": "string"
+                },
+                "enabled": {
+                    "description": "@Description Whether the schedule fires\n@Example     true",
+                    "type": "boolean"
+                },
+                "id": {
+                    "description": "@Description Schedule ID",
+                    "type": "string"
+                },
+                "interval_ms": {
+                    "description": "@Description Interval between runs in milliseconds, zero for cron schedules\n@Example     60000",
+                    "type": "integer"
+                },
+                "last_run_at": {
+                    "description": "@Description Last tick tasks were enqueued for",
+                    "type": "string"
+                },
+                "misfire_policy": {
+                    "description": "@Description Misfire policy: skip or catch_up\n@Example     skip",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "@Description Unique schedule name\n@Example     nightly-report",
+                    "type": "string"
+                },
+                "next_run_at": {
+                    "description": "@Description Next tick the schedule is due at",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "@Description Payload template of the enqueued tasks",
+                    "type": "object"
+                },
+                "priority": {
+                    "description": "@Description Priority of the enqueued tasks\n@Example     10",
+                    "type": "integer"
+                },
+                "task_type": {
+                    "description": "@Description Type of the enqueued tasks\n@Example     simulated",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "@Description Last update time",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.StreamEventResponse": {
+            "description": "Task lifecycle event, sent as the data of an SSE message",
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "description": "@Description Attempt number at the time of the event\n@Example     1",
+                    "type": "integer"
+                },
+                "error_message": {
+                    "description": "@Description Error message of a failed attempt\n@Example     connection refused",
+                    "type": "string"
+                },
+                "event": {
+                    "description": "@Description What happened (acquired, processed, failed, dead_lettered, released)\n@Example     processed",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Event ID, also sent as the SSE id to resume from\n@Example     1024",
+                    "type": "integer"
+                },
+                "occurred_at": {
+                    "description": "@Description When the event happened",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "@Description Task status after the event\n@Example     PROCESSED",
+                    "type": "string"
+                },
+                "task_id": {
+                    "description": "@Description Task ID\n@Example     123e4567-e89b-12d3-a456-426614174000",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "@Description Task type\n@Example     simulated",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TaskAttemptResponse": {
+            "description": "One processing attempt of a task",
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "description": "@Description Attempt number, starting at 1\n@Example     2",
+                    "type": "integer"
+                },
+                "duration_ms": {
+                    "description": "@Description Duration of the attempt in milliseconds\n@Example     1250",
+                    "type": "integer"
+                },
+                "error_message": {
+                    "description": "@Description Error returned by the handler\n@Example     connection refused",
+                    "type": "string"
+                },
+                "finished_at": {
+                    "description": "@Description When the outcome was known",
+                    "type": "string"
+                },
+                "outcome": {
+                    "description": "@Description Outcome: succeeded, failed, lease_lost or interrupted\n@Example     failed",
+                    "type": "string"
+                },
+                "owner": {
+                    "description": "@Description Instance that ran the attempt\n@Example     worker-1",
+                    "type": "string"
+                },
+                "started_at": {
+                    "description": "@Description When processing started",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TaskEventResponse": {
+            "description": "One state transition of a task",
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "description": "@Description Attempt number at the time of the event\n@Example     2",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "@Description When the transition happened",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Sequence number of the event\n@Example     1024",
+                    "type": "integer"
+                },
+                "message": {
+                    "description": "@Description Error message of a failed attempt\n@Example     connection refused",
+                    "type": "string"
+                },
+                "owner": {
+                    "description": "@Description Instance that acquired the task\n@Example     worker-1",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "@Description Event type\n@Example     failed",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TaskEventsResponse": {
+            "description": "History of a task, oldest event first",
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "@Description Events within the retention period",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskEventResponse"
+                    }
+                },
+                "task_id": {
+                    "description": "@Description Task ID\n@Example     123e4567-e89b-12d3-a456-426614174000",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TaskInput": {
+            "description": "Single task to create",
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "callback_url": {
+                    "description": "@Description URL receiving a signed webhook when the task is processed or dead-lettered\n@Example     https://example.com/hooks/tasks",
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "delay_ms": {
+                    "description": "@Description Delay in milliseconds before the task becomes due\n@Example     600000",
                     "type": "integer",
                     "minimum": 0
                 },
-                "success_rate": {
-                    "description": "@Description Success rate probability (0.0 - 1.0)\n@Example     0.8",
-                    "type": "number",
-                    "maximum": 1,
+                "payload": {
+                    "description": "@Description Arbitrary JSON payload passed to the handler",
+                    "type": "object"
+                },
+                "priority": {
+                    "description": "@Description Higher priorities are processed first (0-1000)\n@Example     10",
+                    "type": "integer",
+                    "maximum": 1000,
                     "minimum": 0
+                },
+                "run_at": {
+                    "description": "@Description Time the task becomes due (RFC 3339), cannot be combined with delay_ms\n@Example     2030-01-01T02:00:00Z",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "@Description Task type used to select a handler\n@Example     simulated",
+                    "type": "string",
+                    "maxLength": 100
                 }
             }
         },
-        "dto.ProcessTasksResponse": {
-            "description": "Response after task processing",
+        "dto.TaskListResponse": {
+            "description": "Page of tasks, newest first",
             "type": "object",
             "properties": {
-                "failed_count": {
-                    "description": "@Description Number of failed tasks\n@Example     2",
+                "next_cursor": {
+                    "description": "@Description Cursor of the next page, absent on the last page",
+                    "type": "string"
+                },
+                "tasks": {
+                    "description": "@Description Tasks on this page",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskResponse"
+                    }
+                }
+            }
+        },
+        "dto.TaskOutcomeResponse": {
+            "description": "Outcome of processing a single task",
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "description": "@Description Number of the attempt made\n@Example     1",
                     "type": "integer"
                 },
-                "processed_count": {
-                    "description": "@Description Total number of tasks processed\n@Example     10",
+                "duration_ms": {
+                    "description": "@Description Processing time in milliseconds\n@Example     120",
                     "type": "integer"
                 },
-                "success_count": {
-                    "description": "@Description Number of successfully processed tasks\n@Example     8",
+                "error": {
+                    "description": "@Description Handler or infrastructure error",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Task ID",
+                    "type": "string"
+                },
+                "outcome": {
+                    "description": "@Description Outcome: processed, failed, dead_lettered, released or error\n@Example     processed",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "@Description Status the task was left in\n@Example     PROCESSED",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.TaskResponse": {
+            "description": "Current state of a task",
+            "type": "object",
+            "properties": {
+                "attempt_history": {
+                    "description": "@Description Every recorded attempt, oldest first. Only returned by the task detail endpoint",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TaskAttemptResponse"
+                    }
+                },
+                "attempts": {
+                    "description": "@Description Processing attempts made so far\n@Example     1",
                     "type": "integer"
+                },
+                "callback_url": {
+                    "description": "@Description URL notified when the task is processed or dead-lettered",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "@Description Creation time",
+                    "type": "string"
+                },
+                "dead_lettered": {
+                    "description": "@Description Whether the task was moved to the dead-letter queue",
+                    "type": "boolean"
+                },
+                "error_message": {
+                    "description": "@Description Last error reported for the task",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "@Description Task ID",
+                    "type": "string"
+                },
+                "locked_by": {
+                    "description": "@Description Instance processing the task",
+                    "type": "string"
+                },
+                "locked_until": {
+                    "description": "@Description Lease expiry while the task is processed",
+                    "type": "string"
+                },
+                "max_attempts": {
+                    "description": "@Description Maximum allowed attempts\n@Example     3",
+                    "type": "integer"
+                },
+                "moved_at": {
+                    "description": "@Description When the task was moved to the dead-letter queue",
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "description": "@Description Earliest time of the next attempt while the task is queued",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "@Description Task input",
+                    "type": "object"
+                },
+                "priority": {
+                    "description": "@Description Priority the task was created with\n@Example     10",
+                    "type": "integer"
+                },
+                "result": {
+                    "description": "@Description Handler output, set once the task is processed",
+                    "type": "object"
+                },
+                "run_at": {
+                    "description": "@Description Requested due time, if the task was delayed",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "@Description Current status: NEW, PROCESSING, PROCESSED or FAILED\n@Example     PROCESSED",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "@Description Task type\n@Example     simulated",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "@Description Last update time",
+                    "type": "string"
                 }
             }
         },
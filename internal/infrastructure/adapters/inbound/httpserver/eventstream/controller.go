@@ -0,0 +1,138 @@
+package eventstream
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/eventstream/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+	"task-processor/internal/infrastructure/adapters/outbound/taskstream"
+	"task-processor/internal/infrastructure/shared/validator"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Controller streams task lifecycle events as Server-Sent Events
+type Controller struct {
+	Validator *validator.Validator
+	Hub       *taskstream.Hub
+	KeepAlive time.Duration
+}
+
+// NewController creates a new event stream controller
+func NewController(
+	Validator *validator.Validator,
+	Hub       *taskstream.Hub,
+	KeepAlive time.Duration,
+) *Controller {
+	return &Controller{
+		Validator: Validator,
+		Hub:       Hub,
+		KeepAlive: KeepAlive,
+	}
+}
+
+// RegisterRoutes registers routes for Controller
+func (c *Controller) RegisterRoutes(r chi.Router) {
+	r.Get("/api/v1/tasks/events/stream", c.StreamHandler)
+}
+
+// @Summary      Stream task lifecycle events
+// @Description  Pushes acquired, processed, failed, dead_lettered and released events as Server-Sent Events, with a comment ping while idle. A client reconnecting with Last-Event-ID gets the events it missed, as long as they are still in the buffer of this instance.
+// @Tags         Tasks
+// @Produce      text/event-stream
+// @Param        type          query  string false "Comma-separated task types"
+// @Param        status        query  string false "Comma-separated task statuses after the event"
+// @Param        Last-Event-ID header string false "ID of the last event received"
+// @Success      200 {object} dto.StreamEventResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/tasks/events/stream [get]
+func (c *Controller) StreamHandler(w http.ResponseWriter, r *http.Request) {
+	req, err := dto.ParseStreamEventsRequest(r)
+	if err != nil {
+		utils.SendError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// The stream outlives the server write timeout
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		utils.SendError(w, r, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		sub     *taskstream.Subscription
+		backlog []*domain.StreamEvent
+	)
+	if req.LastEventID != nil {
+		sub, backlog = c.Hub.SubscribeAfter(*req.LastEventID)
+	} else {
+		sub = c.Hub.Subscribe()
+	}
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Keeps reverse proxies from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	for _, event := range backlog {
+		if err := c.send(w, req, event); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(c.KeepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case event, ok := <-sub.Events():
+			// Closed on shutdown or when the client fell behind, it resumes with Last-Event-ID
+			if !ok {
+				return
+			}
+			if err := c.send(w, req, event); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// send writes the event as an SSE message unless the filters exclude it
+func (c *Controller) send(w http.ResponseWriter, req *dto.StreamEventsRequest, event *domain.StreamEvent) error {
+	if !req.Matches(event) {
+		return nil
+	}
+	data, err := json.Marshal(dto.FromDomainStreamEvent(event))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
\ No newline at end of file
@@ -0,0 +1,67 @@
+package dto
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"task-processor/internal/domain"
+)
+
+// @Description Filters and resume position of the event stream
+type StreamEventsRequest struct {
+	// @Description Comma-separated task types, all types when empty
+	Types       []string `validate:"dive,max=100"`
+
+	// @Description Comma-separated statuses after the event (NEW, PROCESSING, PROCESSED, FAILED)
+	Statuses    []string `validate:"dive,oneof=NEW PROCESSING PROCESSED FAILED"`
+
+	// @Description ID of the last event the client received, from the Last-Event-ID header
+	LastEventID *uint64
+}
+
+// ParseStreamEventsRequest reads the filters from the query string and the
+// resume position from the Last-Event-ID header
+func ParseStreamEventsRequest(r *http.Request) (*StreamEventsRequest, error) {
+	query := r.URL.Query()
+	req := &StreamEventsRequest{
+		Types:    splitList(query["type"]),
+		Statuses: splitList(query["status"]),
+	}
+
+	if value := r.Header.Get("Last-Event-ID"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Last-Event-ID must be an event ID")
+		}
+		req.LastEventID = &id
+	}
+
+	return req, nil
+}
+
+// Matches reports whether the event passes the type and status filters
+func (r *StreamEventsRequest) Matches(event *domain.StreamEvent) bool {
+	if len(r.Types) > 0 && !slices.Contains(r.Types, event.TaskType) {
+		return false
+	}
+	if len(r.Statuses) > 0 && !slices.Contains(r.Statuses, string(event.Status)) {
+		return false
+	}
+	return true
+}
+
+// splitList accepts both repeated and comma-separated parameters
+func splitList(values []string) []string {
+	var list []string
+	for _, value := range values {
+		for item := range strings.SplitSeq(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+	return list
+}
\ No newline at end of file
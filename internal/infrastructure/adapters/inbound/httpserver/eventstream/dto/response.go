@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// @Description Task lifecycle event, sent as the data of an SSE message
+type StreamEventResponse struct {
+	// @Description Event ID, also sent as the SSE id to resume from
+	// @Example     1024
+	ID         uint64    `json:"id"`
+
+	// @Description What happened (acquired, processed, failed, dead_lettered, released)
+	// @Example     processed
+	Event      string    `json:"event"`
+
+	// @Description Task ID
+	// @Example     123e4567-e89b-12d3-a456-426614174000
+	TaskID     uuid.UUID `json:"task_id"`
+
+	// @Description Task type
+	// @Example     simulated
+	Type       string    `json:"type"`
+
+	// @Description Task status after the event
+	// @Example     PROCESSED
+	Status     string    `json:"status"`
+
+	// @Description Attempt number at the time of the event
+	// @Example     1
+	Attempt    int       `json:"attempt"`
+
+	// @Description Error message of a failed attempt
+	// @Example     connection refused
+	Message    string    `json:"error_message,omitempty"`
+
+	// @Description When the event happened
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func FromDomainStreamEvent(event *domain.StreamEvent) *StreamEventResponse {
+	return &StreamEventResponse{
+		ID:         event.ID,
+		Event:      string(event.Type),
+		TaskID:     event.TaskID,
+		Type:       event.TaskType,
+		Status:     string(event.Status),
+		Attempt:    event.Attempt,
+		Message:    event.Message,
+		OccurredAt: event.OccurredAt,
+	}
+}
\ No newline at end of file
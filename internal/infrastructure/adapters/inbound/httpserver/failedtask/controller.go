@@ -0,0 +1,236 @@
+package failedtask
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"task-processor/internal/application/usecases/task"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+	"task-processor/internal/infrastructure/shared/validator"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// Controller handles HTTP requests for the dead-letter queue
+type Controller struct {
+	Validator    *validator.Validator
+	TaskUseCases *task.UseCases
+}
+
+// NewController creates a new dead-letter queue controller
+func NewController(
+	Validator    *validator.Validator,
+	TaskUseCases *task.UseCases,
+) *Controller {
+	return &Controller{
+		Validator:    Validator,
+		TaskUseCases: TaskUseCases,
+	}
+}
+
+// RegisterRoutes registers routes for Controller
+func (c *Controller) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/failed-tasks", func(r chi.Router) {
+		r.Get("/", c.ListHandler)
+		r.Post("/requeue", c.RequeueMatchingHandler)
+		r.Post("/purge", c.PurgeMatchingHandler)
+		r.Get("/{id}", c.GetHandler)
+		r.Post("/{id}/requeue", c.RequeueHandler)
+		r.Delete("/{id}", c.PurgeHandler)
+	})
+}
+
+// @Summary      List dead-lettered tasks
+// @Description  Returns tasks from the dead-letter queue newest first, filtered and paginated with a keyset cursor
+// @Tags         Failed tasks
+// @Produce      json
+// @Param        type         query string false "Task type"
+// @Param        created_from query string false "Created at or after (RFC 3339)"
+// @Param        created_to   query string false "Created before (RFC 3339)"
+// @Param        error        query string false "Text contained in the last error"
+// @Param        cursor       query string false "next_cursor of the previous page"
+// @Param        limit        query int    false "Page size (1-500), defaults to 50"
+// @Success      200 {object} dto.TaskListResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/failed-tasks [get]
+func (c *Controller) ListHandler(w http.ResponseWriter, r *http.Request) {
+	req, err := dto.ParseListFailedTasksRequest(r.URL.Query())
+	if err != nil {
+		utils.SendError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	page, err := c.TaskUseCases.DeadLetter.ListTasks(r.Context(), req.ToDomainList())
+	switch {
+	case errors.Is(err, domain.ErrInvalidCursor):
+		utils.SendError(w, r, "Invalid cursor", http.StatusBadRequest)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to list failed tasks", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainTaskPage(page), http.StatusOK)
+}
+
+// @Summary      Get a dead-lettered task
+// @Description  Returns a task from the dead-letter queue
+// @Tags         Failed tasks
+// @Produce      json
+// @Param        id  path string true "Task ID"
+// @Success      200 {object} dto.TaskResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/failed-tasks/{id} [get]
+func (c *Controller) GetHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	found, err := c.TaskUseCases.DeadLetter.GetTask(r.Context(), id)
+	switch {
+	case errors.Is(err, domain.ErrTaskNotFound):
+		utils.SendError(w, r, "Failed task not found", http.StatusNotFound)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to get failed task", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainTask(found), http.StatusOK)
+}
+
+// @Summary      Requeue a dead-lettered task
+// @Description  Moves a task back to the queue as NEW with attempts reset, keeping its ID
+// @Tags         Failed tasks
+// @Produce      json
+// @Param        id  path string true "Task ID"
+// @Success      204
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/failed-tasks/{id}/requeue [post]
+func (c *Controller) RequeueHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	err = c.TaskUseCases.DeadLetter.Requeue(r.Context(), id)
+	switch {
+	case errors.Is(err, domain.ErrTaskNotFound):
+		utils.SendError(w, r, "Failed task not found", http.StatusNotFound)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to requeue task", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Purge a dead-lettered task
+// @Description  Permanently deletes a task from the dead-letter queue
+// @Tags         Failed tasks
+// @Produce      json
+// @Param        id  path string true "Task ID"
+// @Success      204
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/failed-tasks/{id} [delete]
+func (c *Controller) PurgeHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	err = c.TaskUseCases.DeadLetter.Purge(r.Context(), id)
+	switch {
+	case errors.Is(err, domain.ErrTaskNotFound):
+		utils.SendError(w, r, "Failed task not found", http.StatusNotFound)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to purge task", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// @Summary      Requeue dead-lettered tasks by filter
+// @Description  Moves up to limit matching tasks (oldest first) back to the queue in one transaction
+// @Tags         Failed tasks
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.FailedTasksBulkRequest true "Filter and limit"
+// @Success      200 {object} dto.FailedTasksBulkResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/failed-tasks/requeue [post]
+func (c *Controller) RequeueMatchingHandler(w http.ResponseWriter, r *http.Request) {
+	var req dto.FailedTasksBulkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(w, r, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	count, err := c.TaskUseCases.DeadLetter.RequeueMatching(r.Context(), req.ToDomainBulk())
+	if err != nil {
+		utils.SendError(w, r, "Failed to requeue tasks", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainFailedTasksBulk(count), http.StatusOK)
+}
+
+// @Summary      Purge dead-lettered tasks by filter
+// @Description  Permanently deletes up to limit matching tasks (oldest first) from the dead-letter queue
+// @Tags         Failed tasks
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.FailedTasksBulkRequest true "Filter and limit"
+// @Success      200 {object} dto.FailedTasksBulkResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/failed-tasks/purge [post]
+func (c *Controller) PurgeMatchingHandler(w http.ResponseWriter, r *http.Request) {
+	var req dto.FailedTasksBulkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(w, r, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	count, err := c.TaskUseCases.DeadLetter.PurgeMatching(r.Context(), req.ToDomainBulk())
+	if err != nil {
+		utils.SendError(w, r, "Failed to purge tasks", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainFailedTasksBulk(count), http.StatusOK)
+}
\ No newline at end of file
@@ -0,0 +1,20 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Controller struct {
+	handler http.Handler
+}
+
+func NewController(handler http.Handler) *Controller {
+	return &Controller{handler: handler}
+}
+
+// RegisterRoutes registers the Prometheus scrape endpoint
+func (h *Controller) RegisterRoutes(router chi.Router) {
+	router.Method(http.MethodGet, "/metrics", h.handler)
+}
\ No newline at end of file
@@ -0,0 +1,198 @@
+package schedule
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"task-processor/internal/application/usecases/schedule"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/schedule/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+	"task-processor/internal/infrastructure/shared/validator"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// Controller handles HTTP requests for recurring schedules
+type Controller struct {
+	Validator        *validator.Validator
+	ScheduleUseCases *schedule.UseCases
+}
+
+// NewController creates a new schedule controller
+func NewController(
+	Validator        *validator.Validator,
+	ScheduleUseCases *schedule.UseCases,
+) *Controller {
+	return &Controller{
+		Validator:        Validator,
+		ScheduleUseCases: ScheduleUseCases,
+	}
+}
+
+// RegisterRoutes registers routes for Controller
+func (c *Controller) RegisterRoutes(r chi.Router) {
+	r.Route("/api/v1/schedules", func(r chi.Router) {
+		r.Post("/", c.CreateHandler)
+		r.Get("/", c.ListHandler)
+		r.Get("/{id}", c.GetHandler)
+		r.Put("/{id}", c.UpdateHandler)
+		r.Delete("/{id}", c.DeleteHandler)
+	})
+}
+
+// @Summary      Create a schedule
+// @Description  Creates a recurring schedule that enqueues tasks on a cron expression or a fixed interval
+// @Tags         Schedules
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.ScheduleRequest true "Schedule definition"
+// @Success      201 {object} dto.ScheduleResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      409 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/schedules [post]
+func (c *Controller) CreateHandler(w http.ResponseWriter, r *http.Request) {
+	var req dto.ScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(w, r, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	created, err := c.ScheduleUseCases.Manager.Create(r.Context(), req.ToDomainSchedule())
+	if err != nil {
+		sendScheduleError(w, r, err, "Failed to create schedule")
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainSchedule(created), http.StatusCreated)
+}
+
+// @Summary      List schedules
+// @Description  Returns all recurring schedules ordered by name
+// @Tags         Schedules
+// @Produce      json
+// @Success      200 {array}  dto.ScheduleResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/schedules [get]
+func (c *Controller) ListHandler(w http.ResponseWriter, r *http.Request) {
+	schedules, err := c.ScheduleUseCases.Manager.List(r.Context())
+	if err != nil {
+		utils.SendError(w, r, "Failed to list schedules", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainSchedules(schedules), http.StatusOK)
+}
+
+// @Summary      Get a schedule
+// @Description  Returns a recurring schedule by ID
+// @Tags         Schedules
+// @Produce      json
+// @Param        id  path string true "Schedule ID"
+// @Success      200 {object} dto.ScheduleResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/schedules/{id} [get]
+func (c *Controller) GetHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid schedule ID", http.StatusBadRequest)
+		return
+	}
+
+	found, err := c.ScheduleUseCases.Manager.Get(r.Context(), id)
+	if err != nil {
+		sendScheduleError(w, r, err, "Failed to get schedule")
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainSchedule(found), http.StatusOK)
+}
+
+// @Summary      Replace a schedule
+// @Description  Replaces the definition of a schedule, the next run is recomputed from now
+// @Tags         Schedules
+// @Accept       json
+// @Produce      json
+// @Param        id      path string              true "Schedule ID"
+// @Param        request body dto.ScheduleRequest true "Schedule definition"
+// @Success      200 {object} dto.ScheduleResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      409 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/schedules/{id} [put]
+func (c *Controller) UpdateHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid schedule ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.ScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(w, r, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	updated, err := c.ScheduleUseCases.Manager.Update(r.Context(), id, req.ToDomainSchedule())
+	if err != nil {
+		sendScheduleError(w, r, err, "Failed to update schedule")
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainSchedule(updated), http.StatusOK)
+}
+
+// @Summary      Delete a schedule
+// @Description  Deletes a schedule, tasks it already enqueued are kept
+// @Tags         Schedules
+// @Produce      json
+// @Param        id  path string true "Schedule ID"
+// @Success      204
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/schedules/{id} [delete]
+func (c *Controller) DeleteHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid schedule ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.ScheduleUseCases.Manager.Delete(r.Context(), id); err != nil {
+		sendScheduleError(w, r, err, "Failed to delete schedule")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// sendScheduleError maps use case errors to HTTP statuses
+func sendScheduleError(w http.ResponseWriter, r *http.Request, err error, fallback string) {
+	switch {
+	case errors.Is(err, domain.ErrScheduleNotFound):
+		utils.SendError(w, r, "Schedule not found", http.StatusNotFound)
+	case errors.Is(err, domain.ErrScheduleNameTaken):
+		utils.SendError(w, r, "Schedule name is already taken", http.StatusConflict)
+	case errors.Is(err, domain.ErrInvalidSchedule):
+		utils.SendError(w, r, err.Error(), http.StatusBadRequest)
+	default:
+		utils.SendError(w, r, fallback, http.StatusInternalServerError)
+	}
+}
\ No newline at end of file
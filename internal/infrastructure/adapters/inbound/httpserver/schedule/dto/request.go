@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"encoding/json"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"time"
+)
+
+// @Description Request payload for creating or replacing a recurring schedule
+type ScheduleRequest struct {
+	// @Description Unique schedule name
+	// @Example     nightly-report
+	Name string `json:"name" validate:"required,max=100"`
+
+	// @Description Cron expression (5 fields or a descriptor such as @hourly), required unless interval_ms is set
+	// @Example     0 3 * * *
+	CronExpr string `json:"cron_expr,omitempty" validate:"required_without=IntervalMS,max=100"`
+
+	// @Description Fixed interval between runs in milliseconds, cannot be combined with cron_expr
+	// @Example     60000
+	IntervalMS int64 `json:"interval_ms,omitempty" validate:"min=0,excluded_with=CronExpr"`
+
+	// @Description Type of the enqueued tasks
+	// @Example     simulated
+	TaskType string `json:"task_type" validate:"required,max=100"`
+
+	// @Description Payload copied into every task, {{scheduled_at}} is replaced with the tick time
+	Payload json.RawMessage `json:"payload,omitempty" swaggertype:"object"`
+
+	// @Description Priority of the enqueued tasks (0-1000)
+	// @Example     10
+	Priority int `json:"priority" validate:"min=0,max=1000"`
+
+	// @Description What to do with missed ticks: skip (default) or catch_up
+	// @Example     skip
+	MisfirePolicy string `json:"misfire_policy,omitempty" validate:"omitempty,oneof=skip catch_up"`
+
+	// @Description Whether the schedule fires, defaults to true
+	// @Example     true
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ToDomain converts HTTP DTO to domain request (use case input)
+func (r *ScheduleRequest) ToDomainSchedule() *tasksprocessor.ScheduleInput {
+	enabled := true
+	if r.Enabled != nil {
+		enabled = *r.Enabled
+	}
+	return &tasksprocessor.ScheduleInput{
+		Name:          r.Name,
+		CronExpr:      r.CronExpr,
+		Interval:      time.Duration(r.IntervalMS) * time.Millisecond,
+		TaskType:      r.TaskType,
+		Payload:       r.Payload,
+		Priority:      r.Priority,
+		MisfirePolicy: r.MisfirePolicy,
+		Enabled:       enabled,
+	}
+}
\ No newline at end of file
@@ -0,0 +1,82 @@
+package dto
+
+import (
+	"encoding/json"
+	"task-processor/internal/domain"
+	"time"
+)
+
+// @Description Recurring schedule
+type ScheduleResponse struct {
+	// @Description Schedule ID
+	ID string `json:"id"`
+
+	// @Description Unique schedule name
+	// @Example     nightly-report
+	Name string `json:"name"`
+
+	// @Description Cron expression, empty for interval schedules
+	// @Example     0 3 * * *
+	CronExpr string `json:"cron_expr,omitempty"`
+
+	// @Description Interval between runs in milliseconds, zero for cron schedules
+	// @Example     60000
+	IntervalMS int64 `json:"interval_ms,omitempty"`
+
+	// @Description Type of the enqueued tasks
+	// @Example     simulated
+	TaskType string `json:"task_type"`
+
+	// @Description Payload template of the enqueued tasks
+	Payload json.RawMessage `json:"payload" swaggertype:"object"`
+
+	// @Description Priority of the enqueued tasks
+	// @Example     10
+	Priority int `json:"priority"`
+
+	// @Description Misfire policy: skip or catch_up
+	// @Example     skip
+	MisfirePolicy string `json:"misfire_policy"`
+
+	// @Description Whether the schedule fires
+	// @Example     true
+	Enabled bool `json:"enabled"`
+
+	// @Description Next tick the schedule is due at
+	NextRunAt time.Time `json:"next_run_at"`
+
+	// @Description Last tick tasks were enqueued for
+	LastRunAt *time.Time `json:"last_run_at,omitempty"`
+
+	// @Description Creation time
+	CreatedAt time.Time `json:"created_at"`
+
+	// @Description Last update time
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func FromDomainSchedule(schedule *domain.Schedule) *ScheduleResponse {
+	return &ScheduleResponse{
+		ID:            schedule.ID.String(),
+		Name:          schedule.Name,
+		CronExpr:      schedule.CronExpr,
+		IntervalMS:    schedule.Interval.Milliseconds(),
+		TaskType:      schedule.TaskType,
+		Payload:       schedule.Payload,
+		Priority:      schedule.Priority,
+		MisfirePolicy: string(schedule.MisfirePolicy),
+		Enabled:       schedule.Enabled,
+		NextRunAt:     schedule.NextRunAt,
+		LastRunAt:     schedule.LastRunAt,
+		CreatedAt:     schedule.CreatedAt,
+		UpdatedAt:     schedule.UpdatedAt,
+	}
+}
+
+func FromDomainSchedules(schedules []*domain.Schedule) []*ScheduleResponse {
+	resp := make([]*ScheduleResponse, len(schedules))
+	for i, schedule := range schedules {
+		resp[i] = FromDomainSchedule(schedule)
+	}
+	return resp
+}
\ No newline at end of file
@@ -2,15 +2,18 @@ package task
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
 	"task-processor/internal/application/usecases/task"
+	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
 	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
 	"task-processor/internal/infrastructure/shared/validator"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 // Controller handles HTTP requests for task processing
@@ -36,20 +39,28 @@ func NewController(
 // RegisterRoutes registers routes for Controller
 func (c *Controller) RegisterRoutes(r chi.Router) {
 	r.Route("/api/v1/tasks", func(r chi.Router) {
+		r.Get("/", c.ListHandler)
 		r.Post("/process", c.ProcessTasksHandler)
 		r.Post("/batch-create", c.BatchCreateHandler)
+		r.Get("/stats", c.StatsHandler)
+		r.Get("/{id}", c.GetHandler)
+		r.Get("/{id}/events", c.EventsHandler)
+		r.Post("/{id}/reschedule", c.RescheduleHandler)
 	})
+	r.Get("/api/v1/processing-runs/{id}", c.GetRunHandler)
 }
 
 // @Summary      Process multiple tasks
-// @Description  Acquires and processes tasks with configurable parameters
+// @Description  Acquires and processes tasks with configurable parameters. With async the batch runs in the background and the response points to the run to poll.
 // @Tags         Tasks
 // @Accept       json
 // @Produce      json
 // @Param        request body dto.ProcessTasksRequest true "Processing parameters"
 // @Success      200 {object} dto.ProcessTasksResponse
+// @Success      202 {object} dto.ProcessingRunResponse
 // @Failure      400 {object} utils.HTTPResponse
 // @Failure      500 {object} utils.HTTPResponse
+// @Failure      503 {object} utils.HTTPResponse
 // @Router       /api/v1/tasks/process [post]
 func (c *Controller) ProcessTasksHandler(w http.ResponseWriter, r *http.Request) {
 	// Parse and validate request
@@ -68,6 +79,11 @@ func (c *Controller) ProcessTasksHandler(w http.ResponseWriter, r *http.Request)
 	// Convert to domain request
 	domainReq := req.ToDomainProcess()
 
+	if req.Async {
+		c.startRun(w, r, domainReq)
+		return
+	}
+
 	// Process tasks
 	response, err := c.TasksProcessor.ProcessTasks(r.Context(), domainReq)
 	if err != nil {
@@ -82,6 +98,52 @@ func (c *Controller) ProcessTasksHandler(w http.ResponseWriter, r *http.Request)
 	utils.SendSuccess(w, r, httpResponse, http.StatusOK)
 }
 
+// startRun starts a background run and answers with where to poll it
+func (c *Controller) startRun(w http.ResponseWriter, r *http.Request, domainReq *tasksprocessor.ProcessTasksRequest) {
+	run, err := c.TasksProcessor.StartRun(r.Context(), domainReq)
+	switch {
+	case errors.Is(err, domain.ErrShuttingDown):
+		utils.SendError(w, r, "Shutting down, not accepting runs", http.StatusServiceUnavailable)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to start processing run", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Location", "/api/v1/processing-runs/"+run.ID.String())
+	utils.SendSuccess(w, r, dto.FromDomainProcessingRun(run), http.StatusAccepted)
+}
+
+// @Summary      Get a processing run
+// @Description  Returns the progress of a background processing run, or its final counters once finished
+// @Tags         Tasks
+// @Produce      json
+// @Param        id  path string true "Run ID"
+// @Success      200 {object} dto.ProcessingRunResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/processing-runs/{id} [get]
+func (c *Controller) GetRunHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid run ID", http.StatusBadRequest)
+		return
+	}
+
+	run, err := c.TaskUseCases.ProcessingRuns.Get(r.Context(), id)
+	switch {
+	case errors.Is(err, domain.ErrRunNotFound):
+		utils.SendError(w, r, "Processing run not found", http.StatusNotFound)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to get processing run", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainProcessingRun(run), http.StatusOK)
+}
+
 // @Summary      Batch create tasks
 // @Description  Creates multiple tasks in a single operation
 // @Tags         Tasks
@@ -104,7 +166,7 @@ func (c *Controller) BatchCreateHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	ids, err := c.TaskUseCases.Creator.CreateTasksBatch(r.Context(), req.Count)
+	ids, err := c.TaskUseCases.Creator.CreateTasksBatch(r.Context(), req.ToDomainBatchCreate())
 	if err != nil {
 		utils.SendError(w, r, "Failed to create tasks", http.StatusInternalServerError)
 		return
@@ -114,3 +176,177 @@ func (c *Controller) BatchCreateHandler(w http.ResponseWriter, r *http.Request)
 
 	utils.SendSuccess(w, r, httpResponse, http.StatusOK)
 }
+
+// @Summary      List tasks
+// @Description  Returns tasks newest first, filtered and paginated with a keyset cursor
+// @Tags         Tasks
+// @Produce      json
+// @Param        status       query string false "Comma-separated statuses, e.g. NEW,FAILED"
+// @Param        type         query string false "Task type"
+// @Param        created_from query string false "Created at or after (RFC 3339)"
+// @Param        created_to   query string false "Created before (RFC 3339)"
+// @Param        error        query string false "Text contained in the last error"
+// @Param        cursor       query string false "next_cursor of the previous page"
+// @Param        limit        query int    false "Page size (1-500), defaults to 50"
+// @Success      200 {object} dto.TaskListResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/tasks [get]
+func (c *Controller) ListHandler(w http.ResponseWriter, r *http.Request) {
+	req, err := dto.ParseListTasksRequest(r.URL.Query())
+	if err != nil {
+		utils.SendError(w, r, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	page, err := c.TaskUseCases.Lister.ListTasks(r.Context(), req.ToDomainList())
+	switch {
+	case errors.Is(err, domain.ErrInvalidCursor):
+		utils.SendError(w, r, "Invalid cursor", http.StatusBadRequest)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to list tasks", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainTaskPage(page), http.StatusOK)
+}
+
+// @Summary      Queue statistics
+// @Description  Returns task counts per status, exhausted and dead-lettered tasks and the age of the oldest NEW task.
+// @Description  Snapshots are cached briefly, so the endpoint is cheap to poll.
+// @Tags         Tasks
+// @Produce      json
+// @Success      200 {object} dto.QueueStatsResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/tasks/stats [get]
+func (c *Controller) StatsHandler(w http.ResponseWriter, r *http.Request) {
+	stats, err := c.TaskUseCases.Stats.GetStats(r.Context())
+	if err != nil {
+		utils.SendError(w, r, "Failed to collect queue statistics", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainQueueStats(stats), http.StatusOK)
+}
+
+// @Summary      Get a task
+// @Description  Returns the current state of a task, including tasks moved to the dead-letter queue, with the history of its attempts
+// @Tags         Tasks
+// @Produce      json
+// @Param        id  path string true "Task ID"
+// @Success      200 {object} dto.TaskResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/tasks/{id} [get]
+func (c *Controller) GetHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	task, err := c.TaskUseCases.Reader.GetTask(r.Context(), id)
+	switch {
+	case errors.Is(err, domain.ErrTaskNotFound):
+		utils.SendError(w, r, "Task not found", http.StatusNotFound)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to get task", http.StatusInternalServerError)
+		return
+	}
+
+	attempts, err := c.TaskUseCases.Reader.GetAttempts(r.Context(), id)
+	if err != nil {
+		utils.SendError(w, r, "Failed to get task attempts", http.StatusInternalServerError)
+		return
+	}
+
+	resp := dto.FromDomainTask(task)
+	resp.AttemptHistory = dto.FromDomainTaskAttempts(attempts)
+
+	utils.SendSuccess(w, r, resp, http.StatusOK)
+}
+
+// @Summary      Task history
+// @Description  Returns the state transitions of a task, oldest first. Events older than the retention period are pruned.
+// @Tags         Tasks
+// @Produce      json
+// @Param        id  path string true "Task ID"
+// @Success      200 {object} dto.TaskEventsResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/tasks/{id}/events [get]
+func (c *Controller) EventsHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	events, err := c.TaskUseCases.History.GetHistory(r.Context(), id)
+	switch {
+	case errors.Is(err, domain.ErrTaskNotFound):
+		utils.SendError(w, r, "Task not found", http.StatusNotFound)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to get task history", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainTaskEvents(id, events), http.StatusOK)
+}
+
+// @Summary      Reschedule a pending task
+// @Description  Moves a task that has not started processing to a new due time
+// @Tags         Tasks
+// @Accept       json
+// @Produce      json
+// @Param        id      path string                    true "Task ID"
+// @Param        request body dto.RescheduleTaskRequest true "New due time"
+// @Success      200 {object} dto.RescheduleTaskResponse
+// @Failure      400 {object} utils.HTTPResponse
+// @Failure      404 {object} utils.HTTPResponse
+// @Failure      409 {object} utils.HTTPResponse
+// @Failure      500 {object} utils.HTTPResponse
+// @Router       /api/v1/tasks/{id}/reschedule [post]
+func (c *Controller) RescheduleHandler(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.SendError(w, r, "Invalid task ID", http.StatusBadRequest)
+		return
+	}
+
+	var req dto.RescheduleTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		utils.SendError(w, r, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	if err := c.Validator.ValidateStruct(req); err != nil {
+		utils.SendValidationError(w, r, c.Validator, err)
+		return
+	}
+
+	runAt, err := c.TaskUseCases.Rescheduler.Reschedule(r.Context(), id, req.ToDomainReschedule())
+	switch {
+	case errors.Is(err, domain.ErrTaskNotFound):
+		utils.SendError(w, r, "Task not found", http.StatusNotFound)
+		return
+	case errors.Is(err, domain.ErrTaskNotPending):
+		utils.SendError(w, r, "Task is already being processed or finished", http.StatusConflict)
+		return
+	case err != nil:
+		utils.SendError(w, r, "Failed to reschedule task", http.StatusInternalServerError)
+		return
+	}
+
+	utils.SendSuccess(w, r, dto.FromDomainReschedule(id, runAt), http.StatusOK)
+}
\ No newline at end of file
@@ -1,6 +1,17 @@
 package dto
 
-import "task-processor/internal/application/ports/inbound/tasksprocessor"
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+	"time"
+)
+
+// DefaultListLimit is the page size used when the limit query parameter is omitted
+const DefaultListLimit = 50
 
 // @Description Request payload for task processing
 type ProcessTasksRequest struct {
@@ -15,32 +26,250 @@ type ProcessTasksRequest struct {
 	// @Description Maximum processing delay in milliseconds
 	// @Example     500
 	MaxDelayMS int `json:"max_delay_ms" validate:"min=0,gtefield=MinDelayMS"`
-	
-	// @Description Success rate probability (0.0 - 1.0)
-	// @Example     0.8
-	SuccessRate float64 `json:"success_rate" validate:"min=0,max=1"`
+
+	// @Description Return the outcome of every processed task, not available for async runs
+	// @Example     false
+	IncludeDetails bool `json:"include_details" validate:"excluded_with=Async"`
+
+	// @Description Process in the background and return a run to poll instead of waiting
+	// @Example     false
+	Async bool `json:"async"`
 }
 
 // ToDomain converts HTTP DTO to domain request
 func (r *ProcessTasksRequest) ToDomainProcess() *tasksprocessor.ProcessTasksRequest {
 	return &tasksprocessor.ProcessTasksRequest{
-		Limit:       r.Limit,
-		MinDelayMS:  r.MinDelayMS,
-		MaxDelayMS:  r.MaxDelayMS,
-		SuccessRate: r.SuccessRate,
+		Limit:          r.Limit,
+		MinDelayMS:     r.MinDelayMS,
+		MaxDelayMS:     r.MaxDelayMS,
+		IncludeDetails: r.IncludeDetails,
 	}
 }
 
 // @Description Request payload for batch task creation
 type BatchCreateTasksRequest struct {
-	// @Description Number of tasks to create
-	// @Example     5
-	Count int `json:"count" validate:"required,min=1,max=50"`
+	// @Description Tasks to create (1-50)
+	Tasks []TaskInput `json:"tasks" validate:"required,min=1,max=50,dive"`
+}
+
+// @Description Single task to create
+type TaskInput struct {
+	// @Description Task type used to select a handler
+	// @Example     simulated
+	Type string `json:"type" validate:"required,max=100"`
+
+	// @Description Arbitrary JSON payload passed to the handler
+	Payload json.RawMessage `json:"payload,omitempty" swaggertype:"object"`
+
+	// @Description Higher priorities are processed first (0-1000)
+	// @Example     10
+	Priority int `json:"priority" validate:"min=0,max=1000"`
+
+	// @Description Time the task becomes due (RFC 3339), cannot be combined with delay_ms
+	// @Example     2030-01-01T02:00:00Z
+	RunAt *time.Time `json:"run_at,omitempty"`
+
+	// @Description Delay in milliseconds before the task becomes due
+	// @Example     600000
+	DelayMS int `json:"delay_ms,omitempty" validate:"min=0,excluded_with=RunAt"`
+
+	// @Description URL receiving a signed webhook when the task is processed or dead-lettered
+	// @Example     https://example.com/hooks/tasks
+	CallbackURL string `json:"callback_url,omitempty" validate:"omitempty,http_url,max=2048"`
 }
 
 // ToDomain converts HTTP DTO to domain request (use case input)
 func (r *BatchCreateTasksRequest) ToDomainBatchCreate() *tasksprocessor.BatchCreateTasksRequest {
+	tasks := make([]tasksprocessor.TaskInput, len(r.Tasks))
+	for i, t := range r.Tasks {
+		tasks[i] = tasksprocessor.TaskInput{
+			Type:     t.Type,
+			Payload:  t.Payload,
+			Priority: t.Priority,
+			RunAt:    t.RunAt,
+			Delay:    time.Duration(t.DelayMS) * time.Millisecond,
+			CallbackURL: t.CallbackURL,
+		}
+	}
 	return &tasksprocessor.BatchCreateTasksRequest{
-		Count: r.Count,
+		Tasks: tasks,
 	}
 }
+
+
+// @Description Request payload for rescheduling a pending task
+type RescheduleTaskRequest struct {
+	// @Description New due time (RFC 3339), required unless delay_ms is set
+	// @Example     2030-01-01T02:00:00Z
+	RunAt *time.Time `json:"run_at,omitempty" validate:"required_without=DelayMS"`
+
+	// @Description New due time as a delay in milliseconds from now
+	// @Example     600000
+	DelayMS int `json:"delay_ms,omitempty" validate:"min=0,excluded_with=RunAt"`
+}
+
+// ToDomain converts HTTP DTO to domain request (use case input)
+func (r *RescheduleTaskRequest) ToDomainReschedule() *tasksprocessor.RescheduleTaskRequest {
+	return &tasksprocessor.RescheduleTaskRequest{
+		RunAt: r.RunAt,
+		Delay: time.Duration(r.DelayMS) * time.Millisecond,
+	}
+}
+
+// @Description Query parameters of the task listing
+type ListTasksRequest struct {
+	// @Description Comma-separated statuses (NEW, PROCESSING, PROCESSED, FAILED)
+	Statuses    []string   `validate:"dive,oneof=NEW PROCESSING PROCESSED FAILED"`
+
+	// @Description Task type
+	Type        string     `validate:"max=100"`
+
+	// @Description Lower bound of created_at (inclusive, RFC 3339)
+	CreatedFrom *time.Time
+
+	// @Description Upper bound of created_at (exclusive, RFC 3339)
+	CreatedTo   *time.Time
+
+	// @Description Text the last error must contain (case-insensitive)
+	Error       string     `validate:"max=200"`
+
+	// @Description Cursor returned as next_cursor by the previous page
+	Cursor      string     `validate:"max=200"`
+
+	// @Description Page size (1-500)
+	Limit       int        `validate:"min=1,max=500"`
+}
+
+// ParseListTasksRequest reads the listing parameters from the query string
+func ParseListTasksRequest(query url.Values) (*ListTasksRequest, error) {
+	req := &ListTasksRequest{
+		Type:   query.Get("type"),
+		Error:  query.Get("error"),
+		Cursor: query.Get("cursor"),
+	}
+
+	if statuses := query.Get("status"); statuses != "" {
+		req.Statuses = strings.Split(statuses, ",")
+	}
+
+	var err error
+	if req.Limit, err = utils.QueryInt(query, "limit", DefaultListLimit); err != nil {
+		return nil, err
+	}
+	if req.CreatedFrom, err = utils.QueryTime(query, "created_from"); err != nil {
+		return nil, err
+	}
+	if req.CreatedTo, err = utils.QueryTime(query, "created_to"); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ToDomain converts HTTP DTO to domain request (use case input)
+func (r *ListTasksRequest) ToDomainList() *tasksprocessor.ListTasksRequest {
+	statuses := make([]domain.TaskStatus, len(r.Statuses))
+	for i, status := range r.Statuses {
+		statuses[i] = domain.TaskStatus(status)
+	}
+	return &tasksprocessor.ListTasksRequest{
+		Statuses:      statuses,
+		Type:          r.Type,
+		CreatedFrom:   r.CreatedFrom,
+		CreatedTo:     r.CreatedTo,
+		ErrorContains: r.Error,
+		Cursor:        r.Cursor,
+		Limit:         r.Limit,
+	}
+}
+
+// @Description Query parameters of the dead-letter listing
+type ListFailedTasksRequest struct {
+	// @Description Task type
+	Type        string     `validate:"max=100"`
+
+	// @Description Lower bound of created_at (inclusive, RFC 3339)
+	CreatedFrom *time.Time
+
+	// @Description Upper bound of created_at (exclusive, RFC 3339)
+	CreatedTo   *time.Time
+
+	// @Description Text the last error must contain (case-insensitive)
+	Error       string     `validate:"max=200"`
+
+	// @Description Cursor returned as next_cursor by the previous page
+	Cursor      string     `validate:"max=200"`
+
+	// @Description Page size (1-500)
+	Limit       int        `validate:"min=1,max=500"`
+}
+
+// ParseListFailedTasksRequest reads the listing parameters from the query string
+func ParseListFailedTasksRequest(query url.Values) (*ListFailedTasksRequest, error) {
+	req := &ListFailedTasksRequest{
+		Type:   query.Get("type"),
+		Error:  query.Get("error"),
+		Cursor: query.Get("cursor"),
+	}
+
+	var err error
+	if req.Limit, err = utils.QueryInt(query, "limit", DefaultListLimit); err != nil {
+		return nil, err
+	}
+	if req.CreatedFrom, err = utils.QueryTime(query, "created_from"); err != nil {
+		return nil, err
+	}
+	if req.CreatedTo, err = utils.QueryTime(query, "created_to"); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// ToDomain converts HTTP DTO to domain request (use case input)
+func (r *ListFailedTasksRequest) ToDomainList() *tasksprocessor.ListDeadLetterRequest {
+	return &tasksprocessor.ListDeadLetterRequest{
+		Filter: tasksprocessor.DeadLetterFilter{
+			Type:          r.Type,
+			CreatedFrom:   r.CreatedFrom,
+			CreatedTo:     r.CreatedTo,
+			ErrorContains: r.Error,
+		},
+		Cursor: r.Cursor,
+		Limit:  r.Limit,
+	}
+}
+
+// @Description Request payload for bulk requeue or purge of dead-lettered tasks
+type FailedTasksBulkRequest struct {
+	// @Description Only tasks of this type
+	// @Example     simulated
+	Type        string     `json:"type,omitempty" validate:"max=100"`
+
+	// @Description Only tasks created at or after this time (RFC 3339)
+	CreatedFrom *time.Time `json:"created_from,omitempty"`
+
+	// @Description Only tasks created before this time (RFC 3339)
+	CreatedTo   *time.Time `json:"created_to,omitempty"`
+
+	// @Description Only tasks whose last error contains this text (case-insensitive)
+	// @Example     timeout
+	Error       string     `json:"error,omitempty" validate:"max=200"`
+
+	// @Description Maximum number of tasks affected (1-1000), oldest first
+	// @Example     100
+	Limit       int        `json:"limit" validate:"required,min=1,max=1000"`
+}
+
+// ToDomain converts HTTP DTO to domain request (use case input)
+func (r *FailedTasksBulkRequest) ToDomainBulk() *tasksprocessor.DeadLetterBulkRequest {
+	return &tasksprocessor.DeadLetterBulkRequest{
+		Filter: tasksprocessor.DeadLetterFilter{
+			Type:          r.Type,
+			CreatedFrom:   r.CreatedFrom,
+			CreatedTo:     r.CreatedTo,
+			ErrorContains: r.Error,
+		},
+		Limit: r.Limit,
+	}
+}
\ No newline at end of file
@@ -1,7 +1,10 @@
 package dto
 
 import (
+	"encoding/json"
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/domain"
+	"time"
 	"github.com/google/uuid"
 
 )
@@ -16,17 +19,139 @@ type ProcessTasksResponse struct {
 	// @Example     8
 	SuccessCount int `json:"success_count"`
 	
-	// @Description Number of failed tasks
+	// @Description Number of tasks failed by their handler
 	// @Example     2
 	FailedCount int `json:"failed_count"`
+
+	// @Description Number of tasks that hit an infrastructure error, they are retried once their lease expires
+	// @Example     0
+	ErrorCount int `json:"error_count"`
+
+	// @Description Number of tasks returned to the queue because the request was cancelled
+	// @Example     0
+	ReleasedCount int `json:"released_count"`
+
+	// @Description Outcome of every processed task, only with include_details
+	Details []TaskOutcomeResponse `json:"details,omitempty"`
+}
+
+// @Description Outcome of processing a single task
+type TaskOutcomeResponse struct {
+	// @Description Task ID
+	ID         string `json:"id"`
+
+	// @Description Outcome: processed, failed, dead_lettered, released or error
+	// @Example     processed
+	Outcome    string `json:"outcome"`
+
+	// @Description Status the task was left in
+	// @Example     PROCESSED
+	Status     string `json:"status"`
+
+	// @Description Number of the attempt made
+	// @Example     1
+	Attempt    int    `json:"attempt"`
+
+	// @Description Processing time in milliseconds
+	// @Example     120
+	DurationMS int64  `json:"duration_ms"`
+
+	// @Description Handler or infrastructure error
+	Error      string `json:"error,omitempty"`
 }
 
 // FromDomain converts domain response to HTTP DTO
 func FromDomainProcess(domainResponse *tasksprocessor.ProcessTasksResponse) *ProcessTasksResponse {
+	var details []TaskOutcomeResponse
+	if domainResponse.Details != nil {
+		details = make([]TaskOutcomeResponse, len(domainResponse.Details))
+		for i, d := range domainResponse.Details {
+			details[i] = TaskOutcomeResponse{
+				ID:         d.TaskID.String(),
+				Outcome:    string(d.Outcome),
+				Status:     string(d.Status),
+				Attempt:    d.Attempt,
+				DurationMS: d.Duration.Milliseconds(),
+				Error:      d.Error,
+			}
+		}
+	}
+
 	return &ProcessTasksResponse{
 		ProcessedCount: domainResponse.ProcessedCount,
 		SuccessCount:   domainResponse.SuccessCount,
 		FailedCount:    domainResponse.FailedCount,
+		ErrorCount:     domainResponse.ErrorCount,
+		ReleasedCount:  domainResponse.ReleasedCount,
+		Details:        details,
+	}
+}
+
+// @Description Background processing run
+type ProcessingRunResponse struct {
+	// @Description Run ID
+	ID             string     `json:"id"`
+
+	// @Description RUNNING, COMPLETED or FAILED
+	// @Example     RUNNING
+	Status         string     `json:"status"`
+
+	// @Description Maximum number of tasks to process
+	// @Example     10
+	Limit          int        `json:"limit"`
+
+	// @Description Number of tasks acquired so far
+	// @Example     10
+	AcquiredCount  int        `json:"acquired_count"`
+
+	// @Description Number of tasks processed so far
+	// @Example     8
+	ProcessedCount int        `json:"processed_count"`
+
+	// @Description Number of successfully processed tasks
+	// @Example     7
+	SuccessCount   int        `json:"success_count"`
+
+	// @Description Number of tasks failed by their handler
+	// @Example     1
+	FailedCount    int        `json:"failed_count"`
+
+	// @Description Number of tasks that hit an infrastructure error
+	// @Example     0
+	ErrorCount     int        `json:"error_count"`
+
+	// @Description Number of tasks returned to the queue
+	// @Example     0
+	ReleasedCount  int        `json:"released_count"`
+
+	// @Description Why the run failed
+	ErrorMessage   string     `json:"error_message,omitempty"`
+
+	// @Description Creation time
+	CreatedAt      time.Time  `json:"created_at"`
+
+	// @Description Last progress update
+	UpdatedAt      time.Time  `json:"updated_at"`
+
+	// @Description Time the run finished
+	FinishedAt     *time.Time `json:"finished_at,omitempty"`
+}
+
+func FromDomainProcessingRun(run *domain.ProcessingRun) *ProcessingRunResponse {
+	return &ProcessingRunResponse{
+		ID:             run.ID.String(),
+		Status:         string(run.Status),
+		Limit:          run.Limit,
+		AcquiredCount:  run.Counters.Acquired,
+		ProcessedCount: run.Counters.Processed,
+		SuccessCount:   run.Counters.Succeeded,
+		FailedCount:    run.Counters.Failed,
+		ErrorCount:     run.Counters.Errors,
+		ReleasedCount:  run.Counters.Released,
+		ErrorMessage:   run.ErrorMessage,
+		CreatedAt:      run.CreatedAt,
+		UpdatedAt:      run.UpdatedAt,
+		FinishedAt:     run.FinishedAt,
 	}
 }
 
@@ -44,4 +169,289 @@ func FromDomainBatchCreate(ids []uuid.UUID) *BatchCreateTasksResponse {
     return &BatchCreateTasksResponse{
         IDs: strIDs,
     }
+}
+
+// @Description Response after rescheduling a task
+type RescheduleTaskResponse struct {
+	// @Description Task ID
+	ID    string    `json:"id"`
+
+	// @Description New due time
+	RunAt time.Time `json:"run_at"`
+}
+
+func FromDomainReschedule(id uuid.UUID, runAt time.Time) *RescheduleTaskResponse {
+	return &RescheduleTaskResponse{
+		ID:    id.String(),
+		RunAt: runAt,
+	}
+}
+
+// @Description Current state of a task
+type TaskResponse struct {
+	// @Description Task ID
+	ID           string          `json:"id"`
+
+	// @Description Task type
+	// @Example     simulated
+	Type         string          `json:"type"`
+
+	// @Description Current status: NEW, PROCESSING, PROCESSED or FAILED
+	// @Example     PROCESSED
+	Status       string          `json:"status"`
+
+	// @Description Priority the task was created with
+	// @Example     10
+	Priority     int             `json:"priority"`
+
+	// @Description Processing attempts made so far
+	// @Example     1
+	Attempts     int             `json:"attempts"`
+
+	// @Description Maximum allowed attempts
+	// @Example     3
+	MaxAttempts  int             `json:"max_attempts"`
+
+	// @Description Task input
+	Payload      json.RawMessage `json:"payload" swaggertype:"object"`
+
+	// @Description Handler output, set once the task is processed
+	Result       json.RawMessage `json:"result,omitempty" swaggertype:"object"`
+
+	// @Description Last error reported for the task
+	ErrorMessage string          `json:"error_message,omitempty"`
+
+	// @Description Whether the task was moved to the dead-letter queue
+	DeadLettered bool            `json:"dead_lettered"`
+
+	// @Description Creation time
+	CreatedAt    time.Time       `json:"created_at"`
+
+	// @Description Last update time
+	UpdatedAt    time.Time       `json:"updated_at"`
+
+	// @Description Requested due time, if the task was delayed
+	RunAt        *time.Time      `json:"run_at,omitempty"`
+
+	// @Description Earliest time of the next attempt while the task is queued
+	NextAttemptAt *time.Time     `json:"next_attempt_at,omitempty"`
+
+	// @Description Instance processing the task
+	LockedBy     string          `json:"locked_by,omitempty"`
+
+	// @Description Lease expiry while the task is processed
+	LockedUntil  *time.Time      `json:"locked_until,omitempty"`
+
+	// @Description When the task was moved to the dead-letter queue
+	MovedAt      *time.Time      `json:"moved_at,omitempty"`
+
+	// @Description URL notified when the task is processed or dead-lettered
+	CallbackURL  string          `json:"callback_url,omitempty"`
+
+	// @Description Every recorded attempt, oldest first. Only returned by the task detail endpoint
+	AttemptHistory []*TaskAttemptResponse `json:"attempt_history,omitempty"`
+}
+
+// @Description One processing attempt of a task
+type TaskAttemptResponse struct {
+	// @Description Attempt number, starting at 1
+	// @Example     2
+	Attempt      int       `json:"attempt"`
+
+	// @Description Instance that ran the attempt
+	// @Example     worker-1
+	Owner        string    `json:"owner"`
+
+	// @Description Outcome: succeeded, failed, lease_lost or interrupted
+	// @Example     failed
+	Outcome      string    `json:"outcome"`
+
+	// @Description Error returned by the handler
+	// @Example     connection refused
+	ErrorMessage string    `json:"error_message,omitempty"`
+
+	// @Description When processing started
+	StartedAt    time.Time `json:"started_at"`
+
+	// @Description When the outcome was known
+	FinishedAt   time.Time `json:"finished_at"`
+
+	// @Description Duration of the attempt in milliseconds
+	// @Example     1250
+	DurationMS   int64     `json:"duration_ms"`
+}
+
+func FromDomainTaskAttempts(attempts []*domain.TaskAttempt) []*TaskAttemptResponse {
+	resp := make([]*TaskAttemptResponse, 0, len(attempts))
+	for _, attempt := range attempts {
+		resp = append(resp, &TaskAttemptResponse{
+			Attempt:      attempt.Number,
+			Owner:        attempt.Owner,
+			Outcome:      string(attempt.Outcome),
+			ErrorMessage: attempt.ErrorMessage,
+			StartedAt:    attempt.StartedAt,
+			FinishedAt:   attempt.FinishedAt,
+			DurationMS:   attempt.Duration().Milliseconds(),
+		})
+	}
+	return resp
+}
+
+func FromDomainTask(task *domain.Task) *TaskResponse {
+	resp := &TaskResponse{
+		ID:           task.ID.String(),
+		Type:         task.Type,
+		Status:       string(task.Status),
+		Priority:     task.Priority,
+		Attempts:     task.Attempts,
+		MaxAttempts:  task.MaxAttempts,
+		Payload:      task.Payload,
+		Result:       task.Result,
+		ErrorMessage: task.ErrorMessage,
+		DeadLettered: task.MovedAt != nil,
+		CreatedAt:    task.CreatedAt,
+		UpdatedAt:    task.UpdatedAt,
+		RunAt:        task.RunAt,
+		LockedBy:     task.LockedBy,
+		LockedUntil:  task.LockedUntil,
+		MovedAt:      task.MovedAt,
+		CallbackURL:  task.CallbackURL,
+	}
+	if !task.NextAttemptAt.IsZero() {
+		resp.NextAttemptAt = &task.NextAttemptAt
+	}
+	return resp
+}
+
+// @Description Page of tasks, newest first
+type TaskListResponse struct {
+	// @Description Tasks on this page
+	Tasks      []*TaskResponse `json:"tasks"`
+
+	// @Description Cursor of the next page, absent on the last page
+	NextCursor string          `json:"next_cursor,omitempty"`
+}
+
+func FromDomainTaskPage(page *tasksprocessor.TaskPage) *TaskListResponse {
+	tasks := make([]*TaskResponse, len(page.Tasks))
+	for i, task := range page.Tasks {
+		tasks[i] = FromDomainTask(task)
+	}
+	return &TaskListResponse{
+		Tasks:      tasks,
+		NextCursor: page.NextCursor,
+	}
+}
+
+// @Description Result of a bulk requeue or purge
+type FailedTasksBulkResponse struct {
+	// @Description Number of tasks affected
+	// @Example     42
+	Count int `json:"count"`
+}
+
+func FromDomainFailedTasksBulk(count int) *FailedTasksBulkResponse {
+	return &FailedTasksBulkResponse{Count: count}
+}
+
+// @Description Snapshot of the queue depth, possibly a few seconds old
+type QueueStatsResponse struct {
+	// @Description Number of tasks in each status
+	Counts              map[string]int `json:"counts"`
+
+	// @Description Tasks currently being processed
+	// @Example     4
+	Processing          int            `json:"processing"`
+
+	// @Description FAILED tasks without attempts left, not yet moved to the dead-letter queue
+	// @Example     2
+	Exhausted           int            `json:"exhausted"`
+
+	// @Description Tasks in the dead-letter queue
+	// @Example     17
+	DeadLettered        int            `json:"dead_lettered"`
+
+	// @Description Age of the oldest NEW task in seconds, 0 when there are none
+	// @Example     12.5
+	OldestNewAgeSeconds float64        `json:"oldest_new_age_seconds"`
+
+	// @Description Creation time of the oldest NEW task
+	OldestNewCreatedAt  *time.Time     `json:"oldest_new_created_at,omitempty"`
+
+	// @Description When the snapshot was taken
+	CollectedAt         time.Time      `json:"collected_at"`
+}
+
+func FromDomainQueueStats(stats *domain.QueueStats) *QueueStatsResponse {
+	statuses := []domain.TaskStatus{
+		domain.StatusNew, domain.StatusProcessing, domain.StatusProcessed, domain.StatusFailed,
+	}
+	counts := make(map[string]int, len(statuses))
+	for _, status := range statuses {
+		counts[string(status)] = stats.Count(status)
+	}
+
+	return &QueueStatsResponse{
+		Counts:              counts,
+		Processing:          stats.Count(domain.StatusProcessing),
+		Exhausted:           stats.Exhausted,
+		DeadLettered:        stats.DeadLettered,
+		OldestNewAgeSeconds: stats.OldestNewAge().Seconds(),
+		OldestNewCreatedAt:  stats.OldestNewAt,
+		CollectedAt:         stats.CollectedAt,
+	}
+}
+
+// @Description One state transition of a task
+type TaskEventResponse struct {
+	// @Description Sequence number of the event
+	// @Example     1024
+	ID        int64     `json:"id"`
+
+	// @Description Event type
+	// @Example     failed
+	Type      string    `json:"type"`
+
+	// @Description Attempt number at the time of the event
+	// @Example     2
+	Attempt   int       `json:"attempt"`
+
+	// @Description Error message of a failed attempt
+	// @Example     connection refused
+	Message   string    `json:"message,omitempty"`
+
+	// @Description Instance that acquired the task
+	// @Example     worker-1
+	Owner     string    `json:"owner,omitempty"`
+
+	// @Description When the transition happened
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// @Description History of a task, oldest event first
+type TaskEventsResponse struct {
+	// @Description Task ID
+	// @Example     123e4567-e89b-12d3-a456-426614174000
+	TaskID uuid.UUID            `json:"task_id"`
+
+	// @Description Events within the retention period
+	Events []*TaskEventResponse `json:"events"`
+}
+
+func FromDomainTaskEvents(taskID uuid.UUID, events []*domain.TaskEvent) *TaskEventsResponse {
+	resp := &TaskEventsResponse{
+		TaskID: taskID,
+		Events: make([]*TaskEventResponse, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &TaskEventResponse{
+			ID:        event.ID,
+			Type:      string(event.Type),
+			Attempt:   event.Attempt,
+			Message:   event.Message,
+			Owner:     event.Owner,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return resp
 }
\ No newline at end of file
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// QueryTime reads an optional RFC 3339 query parameter
+func QueryTime(query url.Values, name string) (*time.Time, error) {
+	value := query.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be an RFC 3339 time", name)
+	}
+	return &t, nil
+}
+
+// QueryInt reads an optional integer query parameter, returning def when it is absent
+func QueryInt(query url.Values, name string, def int) (int, error) {
+	value := query.Get(name)
+	if value == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", name)
+	}
+	return n, nil
+}
\ No newline at end of file
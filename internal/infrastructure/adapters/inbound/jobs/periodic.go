@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"context"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// PeriodicJob runs a maintenance function on a fixed interval as a run.Group actor
+type PeriodicJob struct {
+	log      logger.Logger
+	name     string
+	interval time.Duration
+	fn       func(ctx context.Context) (int, error)
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+func NewPeriodicJob(
+	log      logger.Logger,
+	name     string,
+	interval time.Duration,
+	fn       func(ctx context.Context) (int, error),
+) *PeriodicJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PeriodicJob{
+		log:      log.With(zap.String("job", name)),
+		name:     name,
+		interval: interval,
+		fn:       fn,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+// Run executes the job every interval until Stop is called
+func (j *PeriodicJob) Run() error {
+	j.log.Info("starting periodic job", zap.Duration("interval", j.interval))
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-j.ctx.Done():
+			return nil
+		case <-ticker.C:
+			j.runOnce()
+		}
+	}
+}
+
+// Stop interrupts Run and the execution in progress
+func (j *PeriodicJob) Stop(error) {
+	j.log.Info("stopping periodic job")
+	j.cancel()
+}
+
+func (j *PeriodicJob) runOnce() {
+	count, err := j.fn(j.ctx)
+	if err != nil {
+		if j.ctx.Err() == nil {
+			j.log.Error("periodic job failed", zap.Error(err))
+		}
+		return
+	}
+	if count > 0 {
+		j.log.Info("periodic job completed", zap.Int("count", count))
+	}
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"task-processor/internal/infrastructure/shared/logger"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPeriodicJob_RunsUntilStopped(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+
+	var calls atomic.Int32
+	job := NewPeriodicJob(log, "test", 5*time.Millisecond, func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		return 1, nil
+	})
+
+	done := make(chan error, 1)
+	go func() { done <- job.Run() }()
+
+	assert.Eventually(t, func() bool { return calls.Load() >= 2 }, time.Second, time.Millisecond)
+
+	job.Stop(nil)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("job did not stop")
+	}
+}
+
+func TestPeriodicJob_ContinuesAfterError(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+
+	var calls atomic.Int32
+	job := NewPeriodicJob(log, "test", 5*time.Millisecond, func(ctx context.Context) (int, error) {
+		calls.Add(1)
+		return 0, errors.New("db error")
+	})
+
+	go func() { _ = job.Run() }()
+	defer job.Stop(nil)
+
+	assert.Eventually(t, func() bool { return calls.Load() >= 2 }, time.Second, time.Millisecond)
+}
\ No newline at end of file
@@ -0,0 +1,91 @@
+package tasksprocessor
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/usecases/task"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/shared/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// StartRun records a processing run and executes it in the background. The run
+// outlives the request that started it and is drained on shutdown like any batch.
+func (a *ConcurrentTasksProcessor) StartRun(
+	ctx context.Context,
+	req *tasksprocessor.ProcessTasksRequest,
+) (*domain.ProcessingRun, error) {
+	if !a.begin() {
+		return nil, domain.ErrShuttingDown
+	}
+
+	run, err := a.taskUseCases.ProcessingRuns.Start(ctx, req)
+	if err != nil {
+		a.inFlight.Done()
+		return nil, err
+	}
+
+	// Details are not kept for runs, only the counters
+	runReq := *req
+	runReq.IncludeDetails = false
+	runCtx := context.WithoutCancel(ctx)
+	progress := &runProgress{id: run.ID, runs: a.taskUseCases.ProcessingRuns, log: a.log}
+
+	go func() {
+		defer a.inFlight.Done()
+
+		// The run stays fresh while it executes, a run left behind by a stopped instance turns stale
+		stopKeepAlive := a.taskUseCases.ProcessingRuns.KeepAlive(runCtx, run.ID)
+		_, err := a.process(runCtx, &runReq, progress)
+		stopKeepAlive()
+		err = a.taskUseCases.ProcessingRuns.Finish(runCtx, run.ID, err)
+		switch {
+		case errors.Is(err, domain.ErrRunFinished):
+			// The run went without progress for too long and was failed as orphaned
+			logger.WithTrace(runCtx, a.log).Warn("processing run was already finished",
+				zap.String("run_id", run.ID.String()))
+		case err != nil:
+			logger.WithTrace(runCtx, a.log).Error("failed to finish processing run",
+				zap.String("run_id", run.ID.String()), zap.Error(err))
+		}
+	}()
+
+	return run, nil
+}
+
+// runProgress writes the progress of a background run. A nil runProgress
+// belongs to a synchronous request and records nothing.
+type runProgress struct {
+	id   uuid.UUID
+	runs task.ProcessingRuns
+	log  logger.Logger
+}
+
+// acquired records the number of tasks acquired by the run
+func (p *runProgress) acquired(ctx context.Context, count int) {
+	if p == nil {
+		return
+	}
+	if err := p.runs.Acquired(ctx, p.id, count); err != nil {
+		p.warn(ctx, err)
+	}
+}
+
+// record adds the outcome of a task to the run. Lost updates only make the
+// progress lag, so they are logged rather than failing the task.
+func (p *runProgress) record(ctx context.Context, outcome tasksprocessor.TaskOutcomeKind) {
+	if p == nil {
+		return
+	}
+	if err := p.runs.Record(ctx, p.id, outcome); err != nil {
+		p.warn(ctx, err)
+	}
+}
+
+func (p *runProgress) warn(ctx context.Context, err error) {
+	logger.WithTrace(ctx, p.log).Warn("failed to update processing run",
+		zap.String("run_id", p.id.String()), zap.Error(err))
+}
\ No newline at end of file
@@ -0,0 +1,160 @@
+package tasksprocessor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/usecases/task"
+	"task-processor/internal/application/usecases/task/acquirer"
+	"task-processor/internal/application/usecases/task/processingrun"
+	"task-processor/internal/application/usecases/task/singleprocessor"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
+
+	"github.com/gammazero/workerpool"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestStartRun_ProcessesInBackground(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(2)
+
+	tasks := []*domain.Task{
+		{ID: uuid.New(), Attempts: 1, MaxAttempts: 3},
+		{ID: uuid.New(), Attempts: 1, MaxAttempts: 3},
+	}
+	run := &domain.ProcessingRun{ID: uuid.New(), Status: domain.RunRunning}
+	req := &tasksprocessor.ProcessTasksRequest{Limit: 2, IncludeDetails: true}
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 2).Return(tasks, nil)
+
+	mockProcessor := &singleprocessor.MockSingleProcessor{}
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[0], mock.Anything).Return(true, nil)
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[1], mock.Anything).Return(false, nil)
+
+	finished := make(chan struct{})
+	mockRuns := &processingrun.MockTracker{}
+	mockRuns.On("Start", mock.Anything, req).Return(run, nil)
+	mockRuns.On("KeepAlive", mock.Anything, run.ID).Return(func() {})
+	mockRuns.On("Acquired", mock.Anything, run.ID, 2).Return(nil)
+	mockRuns.On("Record", mock.Anything, run.ID, tasksprocessor.OutcomeProcessed).Return(nil)
+	// A lost progress update does not fail the run
+	mockRuns.On("Record", mock.Anything, run.ID, tasksprocessor.OutcomeFailed).Return(errors.New("db down"))
+	mockRuns.On("Finish", mock.Anything, run.ID, nil).Return(nil).Run(func(mock.Arguments) { close(finished) })
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
+		SingleProcessor: mockProcessor,
+		ProcessingRuns:  mockRuns,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
+
+	// The run outlives the request that started it
+	ctx, cancel := context.WithCancel(context.Background())
+	got, err := processor.StartRun(ctx, req)
+	cancel()
+
+	require.NoError(t, err)
+	assert.Same(t, run, got)
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("run did not finish")
+	}
+	mockRuns.AssertExpectations(t)
+	mockProcessor.AssertExpectations(t)
+}
+
+func TestStartRun_RecordsAcquireFailure(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(1)
+
+	run := &domain.ProcessingRun{ID: uuid.New(), Status: domain.RunRunning}
+	acquireErr := errors.New("acquire fail")
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 5).Return([]*domain.Task{}, acquireErr)
+
+	finished := make(chan struct{})
+	mockRuns := &processingrun.MockTracker{}
+	mockRuns.On("Start", mock.Anything, mock.Anything).Return(run, nil)
+	mockRuns.On("KeepAlive", mock.Anything, run.ID).Return(func() {})
+	mockRuns.On("Finish", mock.Anything, run.ID, mock.MatchedBy(func(err error) bool {
+		return errors.Is(err, acquireErr)
+	})).Return(nil).Run(func(mock.Arguments) { close(finished) })
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
+		SingleProcessor: &singleprocessor.MockSingleProcessor{},
+		ProcessingRuns:  mockRuns,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
+	_, err := processor.StartRun(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 5})
+	require.NoError(t, err)
+
+	select {
+	case <-finished:
+	case <-time.After(5 * time.Second):
+		t.Fatal("run did not finish")
+	}
+	mockRuns.AssertExpectations(t)
+}
+
+func TestStartRun_RefusedWhileDraining(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(1)
+
+	mockRuns := &processingrun.MockTracker{}
+	taskUseCases := &task.UseCases{
+		Acquirer:        &acquirer.MockAcquirer{},
+		Leases:          noHeartbeat,
+		SingleProcessor: &singleprocessor.MockSingleProcessor{},
+		ProcessingRuns:  mockRuns,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
+	processor.Drain(time.Second, time.Second)
+
+	run, err := processor.StartRun(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 1})
+
+	assert.Nil(t, run)
+	assert.ErrorIs(t, err, domain.ErrShuttingDown)
+	mockRuns.AssertNotCalled(t, "Start", mock.Anything, mock.Anything)
+}
+
+func TestStartRun_StartFails(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(1)
+
+	mockRuns := &processingrun.MockTracker{}
+	mockRuns.On("Start", mock.Anything, mock.Anything).Return(nil, errors.New("insert failed"))
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        &acquirer.MockAcquirer{},
+		Leases:          noHeartbeat,
+		SingleProcessor: &singleprocessor.MockSingleProcessor{},
+		ProcessingRuns:  mockRuns,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
+	run, err := processor.StartRun(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 1})
+
+	assert.Nil(t, run)
+	assert.Error(t, err)
+	// The failed start is not left in flight
+	assert.False(t, processor.Drain(time.Second, time.Second).TimedOut)
+}
\ No newline at end of file
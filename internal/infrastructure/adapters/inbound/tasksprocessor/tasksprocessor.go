@@ -2,32 +2,84 @@ package tasksprocessor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
 	"task-processor/internal/application/usecases/task"
+	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
+	"task-processor/internal/infrastructure/shared/tracing"
+	"time"
 
 	"github.com/gammazero/workerpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
+// errDrainTimeout interrupts the tasks still running when draining runs out of time
+var errDrainTimeout = errors.New("task drain timed out")
+
 type ConcurrentTasksProcessor struct {
 	log        	     logger.Logger
 	workerPool 		*workerpool.WorkerPool
 	taskUseCases    *task.UseCases
+	metrics         *metrics.Metrics
+
+	// lifetime is cancelled when draining times out, interrupting the tasks left
+	lifetime        context.Context
+	interrupt       context.CancelCauseFunc
+	// mu orders starting a request against the start of draining
+	mu              sync.Mutex
+	draining        atomic.Bool
+	inFlight        sync.WaitGroup
+	activeTasks     atomic.Int64
+	drained         drainCounters
+}
+
+// drainCounters tally the outcomes of tasks finishing while draining
+type drainCounters struct {
+	completed atomic.Int64
+	released  atomic.Int64
+	abandoned atomic.Int64
+}
+
+// DrainSummary describes what happened to the tasks in flight when draining started
+type DrainSummary struct {
+	// InFlight is the number of tasks being processed when draining started
+	InFlight  int
+	// Completed tasks reached an outcome, successful or not, within the timeout
+	Completed int
+	// Released tasks were interrupted and returned to the queue without counting the attempt
+	Released  int
+	// Abandoned tasks were interrupted but could not be released, they are
+	// picked up again once their lease expires
+	Abandoned int
+	// Stuck tasks ignored the interrupt and were still running when draining
+	// gave up on them, they are picked up again once their lease expires
+	Stuck     int
+	// TimedOut reports whether tasks were still running when the timeout passed
+	TimedOut  bool
+	Elapsed   time.Duration
 }
 
 func NewConcurrentTasksProcessor(
 	log        	     logger.Logger,
 	workerPool 		*workerpool.WorkerPool,
 	taskUseCases    *task.UseCases,
-) tasksprocessor.TasksProcessor {
+	metrics         *metrics.Metrics,
+) *ConcurrentTasksProcessor {
+	lifetime, interrupt := context.WithCancelCause(context.Background())
 	return &ConcurrentTasksProcessor{
 		log: 			  log,
 		workerPool: 	  workerPool,
 		taskUseCases:     taskUseCases,
+		metrics:          metrics,
+		lifetime:         lifetime,
+		interrupt:        interrupt,
 	}
 }
 
@@ -35,52 +87,321 @@ func (a *ConcurrentTasksProcessor) ProcessTasks(
 	ctx context.Context, 
 	req *tasksprocessor.ProcessTasksRequest,
 ) (*tasksprocessor.ProcessTasksResponse, error) {
-	a.log.Debug("acquiring tasks", zap.Int("limit", req.Limit))
+	if !a.begin() {
+		logger.WithTrace(ctx, a.log).Debug("draining, no tasks acquired")
+		return &tasksprocessor.ProcessTasksResponse{}, nil
+	}
+	defer a.inFlight.Done()
+
+	return a.process(ctx, req, nil)
+}
+
+// process acquires and processes a batch of tasks, reporting progress to run when set
+func (a *ConcurrentTasksProcessor) process(
+	ctx context.Context,
+	req *tasksprocessor.ProcessTasksRequest,
+	run *runProgress,
+) (*tasksprocessor.ProcessTasksResponse, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "tasks.process", trace.WithAttributes(
+		attribute.Int("tasks.limit", req.Limit),
+	))
+	defer span.End()
+	log := logger.WithTrace(ctx, a.log)
+
+	log.Debug("acquiring tasks", zap.Int("limit", req.Limit))
 
-	tasks, err := a.taskUseCases.Acquirer.AcquireTasks(ctx, req.Limit)
+	tasks, err := a.acquire(ctx, req.Limit)
 	if err != nil {
-		a.log.Error("failed to acquire tasks", zap.Error(err))
+		tracing.RecordError(span, err)
+		log.Error("failed to acquire tasks", zap.Error(err))
 		return nil, fmt.Errorf("failed to acquire tasks: %w", err)
 	}
 
 	if len(tasks) == 0 {
-		a.log.Debug("no tasks available for processing")
+		log.Debug("no tasks available for processing")
 		return &tasksprocessor.ProcessTasksResponse{}, nil
 	}
 
-	a.log.Info("processing tasks", zap.Int("count", len(tasks)))
+	log.Info("processing tasks", zap.Int("count", len(tasks)))
+	run.acquired(ctx, len(tasks))
+	a.metrics.TasksAcquired.Add(float64(len(tasks)))
+	a.activeTasks.Add(int64(len(tasks)))
 
-	var successCount, failedCount int64
+	processingCtx, stopProcessing := a.processingContext(ctx)
+	defer stopProcessing()
+
+	var successCount, failedCount, errorCount, releasedCount int64
 	var wg sync.WaitGroup
 
-	for _, task := range tasks {
+	var details []tasksprocessor.TaskOutcome
+	if req.IncludeDetails {
+		details = make([]tasksprocessor.TaskOutcome, len(tasks))
+	}
+
+	for i, task := range tasks {
+		// The lease is kept alive from acquisition on, a task waiting for a
+		// worker would otherwise be reclaimed and run a second time
+		leaseCtx, stopLease := a.taskUseCases.Leases.Hold(processingCtx, task)
+
 		wg.Add(1)
 		a.workerPool.Submit(func() {
 			defer wg.Done()
+			defer a.activeTasks.Add(-1)
+			defer stopLease()
 
-			success, err := a.taskUseCases.SingleProcessor.ProcessTask(ctx, task, req)
+			taskCtx, taskSpan := tracing.Tracer().Start(leaseCtx, "task.process", trace.WithAttributes(
+				attribute.String("task.id", task.ID.String()),
+				attribute.String("task.type", task.Type),
+				attribute.Int("task.attempt", task.Attempts),
+			))
+			defer taskSpan.End()
+			taskLog := logger.WithTrace(taskCtx, a.log)
+
+			// A released task gives its attempt back, remember the one made here
+			attempt := task.Attempts
+			start := time.Now()
+			success, err := a.taskUseCases.SingleProcessor.ProcessTask(taskCtx, task, req)
+			duration := time.Since(start)
+			outcome := classify(task, success, err)
+			a.observe(task, outcome, duration)
+			taskSpan.SetAttributes(attribute.Bool("task.success", success))
+			tracing.RecordError(taskSpan, err)
+			if a.draining.Load() {
+				a.countDrained(taskCtx, err)
+			}
+			if details != nil {
+				details[i] = describe(task, outcome, attempt, duration, err)
+			}
+			// Progress is written on the batch context, which outlives interrupted tasks
+			run.record(ctx, outcome)
 
-			if err != nil || !success {
+			switch outcome {
+			case tasksprocessor.OutcomeReleased:
+				atomic.AddInt64(&releasedCount, 1)
+				taskLog.Debug("task released back to the queue", zap.String("task_id", task.ID.String()))
+			case tasksprocessor.OutcomeError:
+				atomic.AddInt64(&errorCount, 1)
+				taskLog.Warn("task processing error", zap.String("task_id", task.ID.String()), zap.Error(err))
+			case tasksprocessor.OutcomeFailed, tasksprocessor.OutcomeDeadLettered:
 				atomic.AddInt64(&failedCount, 1)
-				a.log.Warn("task processing error", zap.String("task_id", task.ID.String()), zap.Error(err))
-			} else {
+				taskLog.Debug("task failed by handler", zap.String("task_id", task.ID.String()), zap.String("error", task.ErrorMessage))
+			default:
 				atomic.AddInt64(&successCount, 1)
-				a.log.Debug("task processed successfully", zap.String("task_id", task.ID.String()))
+				taskLog.Debug("task processed successfully", zap.String("task_id", task.ID.String()))
 			}
 		})
 	}
 
 	wg.Wait()
 
-	a.log.Info("tasks processing completed",
-		zap.Int("processed", int(successCount + failedCount)),
+	span.SetAttributes(
+		attribute.Int("tasks.acquired", len(tasks)),
+		attribute.Int64("tasks.succeeded", successCount),
+		attribute.Int64("tasks.failed", failedCount),
+		attribute.Int64("tasks.errored", errorCount),
+		attribute.Int64("tasks.released", releasedCount),
+	)
+	log.Info("tasks processing completed",
+		zap.Int("processed", int(successCount + failedCount + errorCount)),
 		zap.Int("success", int(successCount)),
 		zap.Int("failed", int(failedCount)),
+		zap.Int("errors", int(errorCount)),
+		zap.Int("released", int(releasedCount)),
 	)
 
 	return &tasksprocessor.ProcessTasksResponse{
-		ProcessedCount: int(successCount + failedCount),
+		ProcessedCount: int(successCount + failedCount + errorCount),
 		SuccessCount:   int(successCount),
 		FailedCount:    int(failedCount),
+		ErrorCount:     int(errorCount),
+		ReleasedCount:  int(releasedCount),
+		Details:        details,
 	}, nil
+}
+
+// Drain stops acquiring tasks and waits up to timeout for the tasks in flight.
+// Tasks still running then are interrupted, which releases them back to the
+// queue. It returns once every task has finished or been released, or when
+// tasks ignoring the interrupt are still running after releaseTimeout.
+func (a *ConcurrentTasksProcessor) Drain(timeout, releaseTimeout time.Duration) DrainSummary {
+	start := time.Now()
+
+	a.mu.Lock()
+	a.draining.Store(true)
+	a.mu.Unlock()
+
+	summary := DrainSummary{InFlight: int(a.activeTasks.Load())}
+	a.log.Info("draining tasks in flight",
+		zap.Int("in_flight", summary.InFlight),
+		zap.Duration("timeout", timeout),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		a.inFlight.Wait()
+		close(done)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-done:
+	case <-timer.C:
+		summary.TimedOut = true
+		a.log.Warn("task drain timed out, interrupting remaining tasks", zap.Int64("remaining", a.activeTasks.Load()))
+		a.interrupt(errDrainTimeout)
+
+		// Interrupted tasks release themselves within the release timeout,
+		// a handler ignoring its context is left behind
+		releaseTimer := time.NewTimer(releaseTimeout)
+		defer releaseTimer.Stop()
+
+		select {
+		case <-done:
+		case <-releaseTimer.C:
+			summary.Stuck = int(a.activeTasks.Load())
+			a.log.Error("tasks ignored the interrupt, giving up on them", zap.Int("stuck", summary.Stuck))
+		}
+	}
+
+	summary.Completed = int(a.drained.completed.Load())
+	summary.Released = int(a.drained.released.Load())
+	summary.Abandoned = int(a.drained.abandoned.Load())
+	summary.Elapsed = time.Since(start)
+
+	a.log.Info("task drain finished",
+		zap.Int("in_flight", summary.InFlight),
+		zap.Int("completed", summary.Completed),
+		zap.Int("released", summary.Released),
+		zap.Int("abandoned", summary.Abandoned),
+		zap.Int("stuck", summary.Stuck),
+		zap.Bool("timed_out", summary.TimedOut),
+		zap.Duration("elapsed", summary.Elapsed),
+	)
+	return summary
+}
+
+// begin registers a request in flight, unless draining has started
+func (a *ConcurrentTasksProcessor) begin() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.draining.Load() {
+		return false
+	}
+	a.inFlight.Add(1)
+	return true
+}
+
+// processingContext derives the context the tasks of one request run on. It keeps
+// the request values, but once draining has started cancelling the request no
+// longer interrupts the tasks, only the drain timeout does.
+func (a *ConcurrentTasksProcessor) processingContext(ctx context.Context) (context.Context, func()) {
+	processingCtx, cancel := context.WithCancelCause(context.WithoutCancel(ctx))
+
+	stopRequest := context.AfterFunc(ctx, func() {
+		if !a.draining.Load() {
+			cancel(context.Cause(ctx))
+		}
+	})
+	stopLifetime := context.AfterFunc(a.lifetime, func() {
+		cancel(context.Cause(a.lifetime))
+	})
+
+	return processingCtx, func() {
+		stopRequest()
+		stopLifetime()
+		cancel(nil)
+	}
+}
+
+// countDrained tallies the outcome of a task finishing while draining
+func (a *ConcurrentTasksProcessor) countDrained(ctx context.Context, err error) {
+	switch {
+	case errors.Is(err, domain.ErrTaskReleased):
+		a.drained.released.Add(1)
+	case err != nil && ctx.Err() != nil:
+		a.drained.abandoned.Add(1)
+	default:
+		a.drained.completed.Add(1)
+	}
+}
+
+// acquire leases up to limit tasks in its own span, separating acquisition from processing
+func (a *ConcurrentTasksProcessor) acquire(ctx context.Context, limit int) ([]*domain.Task, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "tasks.acquire")
+	defer span.End()
+
+	tasks, err := a.taskUseCases.Acquirer.AcquireTasks(ctx, limit)
+	tracing.RecordError(span, err)
+	span.SetAttributes(attribute.Int("tasks.acquired", len(tasks)))
+	return tasks, err
+}
+
+// classify tells what became of a task from the result of processing it. Errors
+// come from the infrastructure, a handler failure is reported without one; a
+// failed attempt without attempts left means the task went to the dead-letter queue.
+func classify(task *domain.Task, success bool, err error) tasksprocessor.TaskOutcomeKind {
+	switch {
+	case errors.Is(err, domain.ErrTaskReleased):
+		return tasksprocessor.OutcomeReleased
+	case err != nil:
+		return tasksprocessor.OutcomeError
+	case success:
+		return tasksprocessor.OutcomeProcessed
+	case task.Attempts >= task.MaxAttempts:
+		return tasksprocessor.OutcomeDeadLettered
+	default:
+		return tasksprocessor.OutcomeFailed
+	}
+}
+
+// describe builds the outcome reported for a single task
+func describe(
+	task     *domain.Task,
+	outcome  tasksprocessor.TaskOutcomeKind,
+	attempt  int,
+	duration time.Duration,
+	err      error,
+) tasksprocessor.TaskOutcome {
+	detail := tasksprocessor.TaskOutcome{
+		TaskID:   task.ID,
+		Outcome:  outcome,
+		Attempt:  attempt,
+		Duration: duration,
+	}
+
+	switch outcome {
+	case tasksprocessor.OutcomeProcessed:
+		detail.Status = domain.StatusProcessed
+	case tasksprocessor.OutcomeFailed, tasksprocessor.OutcomeDeadLettered:
+		detail.Status = domain.StatusFailed
+		detail.Error = task.ErrorMessage
+	case tasksprocessor.OutcomeReleased:
+		detail.Status = domain.StatusNew
+	case tasksprocessor.OutcomeError:
+		// Nothing was stored, the task keeps its lease until it expires
+		detail.Status = domain.StatusProcessing
+		detail.Error = err.Error()
+	}
+	return detail
+}
+
+// observe records the outcome of a single task
+func (a *ConcurrentTasksProcessor) observe(task *domain.Task, outcome tasksprocessor.TaskOutcomeKind, duration time.Duration) {
+	switch outcome {
+	case tasksprocessor.OutcomeReleased:
+		a.metrics.TasksReleased.Inc()
+	case tasksprocessor.OutcomeProcessed:
+		a.metrics.TasksProcessed.WithLabelValues(task.Type).Inc()
+	case tasksprocessor.OutcomeDeadLettered:
+		a.metrics.TasksFailed.WithLabelValues(task.Type).Inc()
+		a.metrics.TasksDeadLettered.WithLabelValues("processor").Inc()
+	case tasksprocessor.OutcomeError:
+		a.metrics.TasksErrored.WithLabelValues(task.Type).Inc()
+	default:
+		a.metrics.TasksFailed.WithLabelValues(task.Type).Inc()
+	}
+	a.metrics.TaskDuration.WithLabelValues(task.Type, string(outcome)).Observe(duration.Seconds())
 }
\ No newline at end of file
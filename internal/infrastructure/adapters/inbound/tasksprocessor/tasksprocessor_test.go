@@ -3,22 +3,31 @@ package tasksprocessor
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
 	"task-processor/internal/application/usecases/task"
 	"task-processor/internal/application/usecases/task/acquirer"
+	"task-processor/internal/application/usecases/task/leasekeeper"
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
 	"task-processor/internal/application/usecases/task/singleprocessor"
 	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
 
 	"github.com/gammazero/workerpool"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap/zaptest"
 )
 
+// noHeartbeat holds leases without extending them, tests of the heartbeat build their own
+var noHeartbeat = leasekeeper.NewKeeper(nil, domain.Lease{})
+
 func TestProcessTasks_NoTasks(t *testing.T) {
 	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
 	workerPool := workerpool.New(1)
@@ -28,10 +37,11 @@ func TestProcessTasks_NoTasks(t *testing.T) {
 
 	taskUseCases := &task.UseCases{
 		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
 		SingleProcessor: &singleprocessor.MockSingleProcessor{},
 	}
 
-	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases)
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
 	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 10})
 
 	assert.NoError(t, err)
@@ -60,10 +70,11 @@ func TestProcessTasks_AllSuccess(t *testing.T) {
 
 	taskUseCases := &task.UseCases{
 		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
 		SingleProcessor: mockProcessor,
 	}
 
-	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases)
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
 	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 3})
 
 	assert.NoError(t, err)
@@ -86,21 +97,23 @@ func TestProcessTasks_AllFailed(t *testing.T) {
 
 	mockProcessor := &singleprocessor.MockSingleProcessor{}
 	for _, t := range tasks {
-		mockProcessor.On("ProcessTask", mock.Anything, t, mock.Anything).Return(false, errors.New("fail"))
+		mockProcessor.On("ProcessTask", mock.Anything, t, mock.Anything).Return(false, nil)
 	}
 
 	taskUseCases := &task.UseCases{
 		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
 		SingleProcessor: mockProcessor,
 	}
 
-	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases)
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
 	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 2})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 2, resp.ProcessedCount)
 	assert.Equal(t, 0, resp.SuccessCount)
 	assert.Equal(t, 2, resp.FailedCount)
+	assert.Equal(t, 0, resp.ErrorCount)
 }
 
 func TestProcessTasks_PartialSuccess(t *testing.T) {
@@ -118,21 +131,123 @@ func TestProcessTasks_PartialSuccess(t *testing.T) {
 
 	mockProcessor := &singleprocessor.MockSingleProcessor{}
 	mockProcessor.On("ProcessTask", mock.Anything, tasks[0], mock.Anything).Return(true, nil)
-	mockProcessor.On("ProcessTask", mock.Anything, tasks[1], mock.Anything).Return(false, errors.New("fail"))
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[1], mock.Anything).Return(false, nil)
 	mockProcessor.On("ProcessTask", mock.Anything, tasks[2], mock.Anything).Return(true, nil)
 
 	taskUseCases := &task.UseCases{
 		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
 		SingleProcessor: mockProcessor,
 	}
 
-	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases)
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
 	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 3})
 
 	assert.NoError(t, err)
 	assert.Equal(t, 3, resp.ProcessedCount)
 	assert.Equal(t, 2, resp.SuccessCount)
 	assert.Equal(t, 1, resp.FailedCount)
+	assert.Equal(t, 0, resp.ErrorCount)
+}
+
+func TestProcessTasks_SeparatesInfrastructureErrors(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(2)
+	m := metrics.New()
+
+	tasks := []*domain.Task{
+		{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3},
+		{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3},
+	}
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 2).Return(tasks, nil)
+
+	mockProcessor := &singleprocessor.MockSingleProcessor{}
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[0], mock.Anything).Return(false, nil)
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[1], mock.Anything).
+		Return(false, errors.New("failed to mark task as processed"))
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
+		SingleProcessor: mockProcessor,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, m)
+	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.ProcessedCount)
+	assert.Equal(t, 0, resp.SuccessCount)
+	assert.Equal(t, 1, resp.FailedCount)
+	assert.Equal(t, 1, resp.ErrorCount)
+	assert.Nil(t, resp.Details)
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.TasksFailed.WithLabelValues("email")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.TasksErrored.WithLabelValues("email")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.TaskDuration))
+}
+
+func TestProcessTasks_IncludesDetails(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(4)
+
+	tasks := []*domain.Task{
+		{ID: uuid.New(), Attempts: 1, MaxAttempts: 3},
+		{ID: uuid.New(), Attempts: 2, MaxAttempts: 3, ErrorMessage: "boom (attempt 2/3)"},
+		{ID: uuid.New(), Attempts: 3, MaxAttempts: 3, ErrorMessage: "boom (attempt 3/3)"},
+		{ID: uuid.New(), Attempts: 1, MaxAttempts: 3},
+		{ID: uuid.New(), Attempts: 1, MaxAttempts: 3},
+	}
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 5).Return(tasks, nil)
+
+	mockProcessor := &singleprocessor.MockSingleProcessor{}
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[0], mock.Anything).Return(true, nil)
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[1], mock.Anything).Return(false, nil)
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[2], mock.Anything).Return(false, nil)
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[3], mock.Anything).Return(false, errors.New("db down"))
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[4], mock.Anything).
+		Return(false, errors.Join(context.Canceled, domain.ErrTaskReleased))
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
+		SingleProcessor: mockProcessor,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
+	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{
+		Limit:          5,
+		IncludeDetails: true,
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Details, 5) {
+		expected := []struct {
+			outcome tasksprocessor.TaskOutcomeKind
+			status  domain.TaskStatus
+			errMsg  string
+		}{
+			{tasksprocessor.OutcomeProcessed, domain.StatusProcessed, ""},
+			{tasksprocessor.OutcomeFailed, domain.StatusFailed, "boom (attempt 2/3)"},
+			{tasksprocessor.OutcomeDeadLettered, domain.StatusFailed, "boom (attempt 3/3)"},
+			{tasksprocessor.OutcomeError, domain.StatusProcessing, "db down"},
+			{tasksprocessor.OutcomeReleased, domain.StatusNew, ""},
+		}
+		for i, want := range expected {
+			detail := resp.Details[i]
+			assert.Equal(t, tasks[i].ID, detail.TaskID)
+			assert.Equal(t, want.outcome, detail.Outcome)
+			assert.Equal(t, want.status, detail.Status)
+			assert.Equal(t, want.errMsg, detail.Error)
+			assert.Equal(t, tasks[i].Attempts, detail.Attempt)
+		}
+	}
+	assert.Equal(t, 2, resp.FailedCount)
+	assert.Equal(t, 1, resp.ErrorCount)
+	assert.Equal(t, 1, resp.ReleasedCount)
 }
 
 func TestProcessTasks_AcquireError(t *testing.T) {
@@ -144,10 +259,11 @@ func TestProcessTasks_AcquireError(t *testing.T) {
 
 	taskUseCases := &task.UseCases{
 		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
 		SingleProcessor: &singleprocessor.MockSingleProcessor{},
 	}
 
-	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases)
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
 	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 5})
 
 	assert.Nil(t, resp)
@@ -171,10 +287,11 @@ func TestProcessTasks_ContextCanceled(t *testing.T) {
 
 	taskUseCases := &task.UseCases{
 		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
 		SingleProcessor: mockProcessor,
 	}
 
-	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases)
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New())
 
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() 
@@ -183,3 +300,275 @@ func TestProcessTasks_ContextCanceled(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.NoError(t, err)
 }
+
+func TestProcessTasks_RecordsMetrics(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(3)
+	m := metrics.New()
+
+	tasks := []*domain.Task{
+		{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3},
+		{ID: uuid.New(), Type: "email", Attempts: 1, MaxAttempts: 3},
+		{ID: uuid.New(), Type: "email", Attempts: 3, MaxAttempts: 3},
+	}
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 3).Return(tasks, nil)
+
+	mockProcessor := &singleprocessor.MockSingleProcessor{}
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[0], mock.Anything).Return(true, nil)
+	// A failure with attempts left is retried, the last one is dead-lettered
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[1], mock.Anything).Return(false, nil)
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[2], mock.Anything).Return(false, nil)
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
+		SingleProcessor: mockProcessor,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, m)
+	_, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 3})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.TasksAcquired))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.TasksProcessed.WithLabelValues("email")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.TasksFailed.WithLabelValues("email")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.TasksDeadLettered.WithLabelValues("processor")))
+	assert.Zero(t, testutil.ToFloat64(m.TasksErrored.WithLabelValues("email")))
+	assert.Equal(t, 3, testutil.CollectAndCount(m.TaskDuration))
+}
+
+func TestProcessTasks_CountsReleased(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(2)
+	m := metrics.New()
+
+	tasks := []*domain.Task{
+		{ID: uuid.New(), Type: "email"},
+		{ID: uuid.New(), Type: "email"},
+	}
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 2).Return(tasks, nil)
+
+	mockProcessor := &singleprocessor.MockSingleProcessor{}
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[0], mock.Anything).Return(true, nil)
+	mockProcessor.On("ProcessTask", mock.Anything, tasks[1], mock.Anything).
+		Return(false, errors.Join(context.Canceled, domain.ErrTaskReleased))
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
+		SingleProcessor: mockProcessor,
+	}
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, m)
+	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resp.ProcessedCount)
+	assert.Equal(t, 1, resp.SuccessCount)
+	assert.Equal(t, 0, resp.FailedCount)
+	assert.Equal(t, 1, resp.ReleasedCount)
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.TasksReleased))
+	assert.Zero(t, testutil.ToFloat64(m.TasksFailed.WithLabelValues("email")))
+}
+
+// processTaskFunc adapts a function to the SingleProcessor use case
+type processTaskFunc func(ctx context.Context, task *domain.Task, request *tasksprocessor.ProcessTasksRequest) (bool, error)
+
+func (f processTaskFunc) ProcessTask(ctx context.Context, task *domain.Task, request *tasksprocessor.ProcessTasksRequest) (bool, error) {
+	return f(ctx, task, request)
+}
+
+// startBlockingBatch processes one task whose handling runs fn, and waits until it started
+func startBlockingBatch(
+	t *testing.T,
+	ctx context.Context,
+	fn func(ctx context.Context) (bool, error),
+) (*ConcurrentTasksProcessor, *acquirer.MockAcquirer, <-chan *tasksprocessor.ProcessTasksResponse) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(1)
+	t.Cleanup(workerPool.StopWait)
+
+	taskToProcess := &domain.Task{ID: uuid.New(), Type: "email"}
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 1).Return([]*domain.Task{taskToProcess}, nil).Once()
+
+	started := make(chan struct{})
+	blocking := processTaskFunc(func(ctx context.Context, _ *domain.Task, _ *tasksprocessor.ProcessTasksRequest) (bool, error) {
+		close(started)
+		return fn(ctx)
+	})
+
+	processor := NewConcurrentTasksProcessor(log, workerPool, &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          noHeartbeat,
+		SingleProcessor: blocking,
+	}, metrics.New())
+
+	responses := make(chan *tasksprocessor.ProcessTasksResponse, 1)
+	go func() {
+		resp, _ := processor.ProcessTasks(ctx, &tasksprocessor.ProcessTasksRequest{Limit: 1})
+		responses <- resp
+	}()
+	<-started
+
+	return processor, mockAcquirer, responses
+}
+
+func TestDrain_WaitsForTasksInFlight(t *testing.T) {
+	finish := make(chan struct{})
+	requestCtx, cancelRequest := context.WithCancel(context.Background())
+	defer cancelRequest()
+
+	var interrupted atomic.Bool
+	processor, mockAcquirer, responses := startBlockingBatch(t, requestCtx, func(ctx context.Context) (bool, error) {
+		select {
+		case <-finish:
+			return true, nil
+		case <-ctx.Done():
+			interrupted.Store(true)
+			return false, ctx.Err()
+		}
+	})
+
+	drained := make(chan DrainSummary, 1)
+	go func() { drained <- processor.Drain(time.Second, time.Second) }()
+
+	// Cancelling the request no longer interrupts the task once draining started
+	assert.Eventually(t, processor.draining.Load, time.Second, time.Millisecond)
+	cancelRequest()
+	time.Sleep(10 * time.Millisecond)
+	close(finish)
+
+	summary := <-drained
+	assert.False(t, interrupted.Load())
+	assert.Equal(t, 1, summary.InFlight)
+	assert.Equal(t, 1, summary.Completed)
+	assert.Zero(t, summary.Released)
+	assert.False(t, summary.TimedOut)
+	assert.Equal(t, 1, (<-responses).SuccessCount)
+
+	// No more tasks are acquired after draining
+	resp, err := processor.ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 1})
+	assert.NoError(t, err)
+	assert.Zero(t, resp.ProcessedCount)
+	mockAcquirer.AssertNumberOfCalls(t, "AcquireTasks", 1)
+}
+
+func TestDrain_ReleasesTasksAfterTimeout(t *testing.T) {
+	processor, _, responses := startBlockingBatch(t, context.Background(), func(ctx context.Context) (bool, error) {
+		<-ctx.Done()
+		return false, errors.Join(ctx.Err(), domain.ErrTaskReleased)
+	})
+
+	summary := processor.Drain(20*time.Millisecond, time.Second)
+
+	assert.True(t, summary.TimedOut)
+	assert.Equal(t, 1, summary.InFlight)
+	assert.Equal(t, 1, summary.Released)
+	assert.Zero(t, summary.Completed)
+	assert.Equal(t, 1, (<-responses).ReleasedCount)
+}
+
+func TestDrain_CountsTasksThatCouldNotBeReleased(t *testing.T) {
+	processor, _, _ := startBlockingBatch(t, context.Background(), func(ctx context.Context) (bool, error) {
+		<-ctx.Done()
+		return false, errors.Join(ctx.Err(), domain.ErrLeaseLost)
+	})
+
+	summary := processor.Drain(20*time.Millisecond, time.Second)
+
+	assert.True(t, summary.TimedOut)
+	assert.Equal(t, 1, summary.Abandoned)
+	assert.Zero(t, summary.Released)
+}
+
+func TestDrain_GivesUpOnTasksIgnoringInterrupt(t *testing.T) {
+	unblock := make(chan struct{})
+	processor, _, responses := startBlockingBatch(t, context.Background(), func(ctx context.Context) (bool, error) {
+		// The handler never looks at its context
+		<-unblock
+		return true, nil
+	})
+
+	start := time.Now()
+	summary := processor.Drain(20*time.Millisecond, 30*time.Millisecond)
+
+	assert.Less(t, time.Since(start), time.Second)
+	assert.True(t, summary.TimedOut)
+	assert.Equal(t, 1, summary.InFlight)
+	assert.Equal(t, 1, summary.Stuck)
+	assert.Zero(t, summary.Released)
+
+	close(unblock)
+	<-responses
+}
+
+func TestDrain_NothingInFlight(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	processor := NewConcurrentTasksProcessor(log, workerpool.New(1), &task.UseCases{}, metrics.New())
+
+	summary := processor.Drain(time.Second, time.Second)
+
+	assert.Equal(t, DrainSummary{Elapsed: summary.Elapsed}, summary)
+}
+
+func TestProcessTasks_HoldsLeaseWhileTaskWaitsForWorker(t *testing.T) {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	workerPool := workerpool.New(1)
+	t.Cleanup(workerPool.StopWait)
+
+	lease := domain.Lease{Owner: "test-worker", Duration: 30 * time.Millisecond}
+	running, waiting := &domain.Task{ID: uuid.New(), Type: "email"}, &domain.Task{ID: uuid.New(), Type: "email"}
+
+	mockAcquirer := &acquirer.MockAcquirer{}
+	mockAcquirer.On("AcquireTasks", mock.Anything, 2).Return([]*domain.Task{running, waiting}, nil)
+
+	mockRepo := new(taskrepo.MockTaskRepository)
+	extendedWhileWaiting := make(chan struct{})
+	var started atomic.Bool
+	mockRepo.On("ExtendLease", mock.Anything, running.ID, lease).Return(nil)
+	mockRepo.On("ExtendLease", mock.Anything, waiting.ID, lease).Run(func(mock.Arguments) {
+		if !started.Load() {
+			select {
+			case <-extendedWhileWaiting:
+			default:
+				close(extendedWhileWaiting)
+			}
+		}
+	}).Return(nil)
+
+	// The only worker is busy with the first task until the lease of the second was extended
+	processor := processTaskFunc(func(ctx context.Context, task *domain.Task, _ *tasksprocessor.ProcessTasksRequest) (bool, error) {
+		if task == running {
+			select {
+			case <-extendedWhileWaiting:
+			case <-time.After(time.Second):
+			}
+			return true, nil
+		}
+		started.Store(true)
+		return true, nil
+	})
+
+	taskUseCases := &task.UseCases{
+		Acquirer:        mockAcquirer,
+		Leases:          leasekeeper.NewKeeper(mockRepo, lease),
+		SingleProcessor: processor,
+	}
+
+	resp, err := NewConcurrentTasksProcessor(log, workerPool, taskUseCases, metrics.New()).
+		ProcessTasks(context.Background(), &tasksprocessor.ProcessTasksRequest{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, resp.SuccessCount)
+	select {
+	case <-extendedWhileWaiting:
+	default:
+		t.Fatal("lease of the waiting task was not extended")
+	}
+}
\ No newline at end of file
@@ -0,0 +1,126 @@
+package worker
+
+import (
+	"context"
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Waker signals that new tasks may be available
+type Waker interface {
+	// Wake returns a channel receiving a signal when tasks were created
+	Wake() <-chan struct{}
+	// Connected reports whether signals are currently delivered
+	Connected() bool
+}
+
+// Worker continuously feeds acquired tasks to the tasks processor.
+// It is meant to run as a run.Group actor.
+type Worker struct {
+	log       logger.Logger
+	processor tasksprocessor.TasksProcessor
+	cfg       config.Worker
+	waker     Waker
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+func NewWorker(
+	log       logger.Logger,
+	processor tasksprocessor.TasksProcessor,
+	cfg       config.Worker,
+	waker     Waker,
+) *Worker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Worker{
+		log:       log.Named("worker"),
+		processor: processor,
+		cfg:       cfg,
+		waker:     waker,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Run polls for tasks until Stop is called. While the queue is empty the worker
+// sleeps until woken by the waker, re-checking every MaxIdleBackoff in case a
+// signal was missed. Without a connected waker it falls back to polling, doubling
+// the interval on every empty round up to MaxIdleBackoff.
+func (w *Worker) Run() error {
+	w.log.Info("starting worker",
+		zap.Int("batch_size", w.cfg.BatchSize),
+		zap.Duration("poll_interval", w.cfg.PollInterval),
+	)
+
+	backoff := w.cfg.PollInterval
+	for {
+		resp, err := w.processor.ProcessTasks(w.ctx, &tasksprocessor.ProcessTasksRequest{
+			Limit: w.cfg.BatchSize,
+		})
+		if w.ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			w.log.Error("worker iteration failed", zap.Error(err))
+		}
+
+		// Keep draining the queue while there is work
+		if err == nil && resp.ProcessedCount > 0 {
+			backoff = w.cfg.PollInterval
+			continue
+		}
+
+		if w.waker != nil && w.waker.Connected() {
+			backoff = w.cfg.PollInterval
+			if !w.idle(w.cfg.MaxIdleBackoff) {
+				return nil
+			}
+			continue
+		}
+
+		if !w.idle(backoff) {
+			return nil
+		}
+		backoff = w.nextBackoff(backoff)
+	}
+}
+
+// Stop stops acquiring new tasks and interrupts the batch in progress, unless the
+// processor is draining, in which case the batch runs on until the drain ends.
+// Interrupted tasks are released back to the queue without counting the attempt.
+func (w *Worker) Stop(error) {
+	w.log.Info("stopping worker")
+	w.cancel()
+}
+
+// idle waits for the given duration or a wake-up and reports whether the worker should continue
+func (w *Worker) idle(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	var wake <-chan struct{}
+	if w.waker != nil {
+		wake = w.waker.Wake()
+	}
+
+	select {
+	case <-w.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	case <-wake:
+		return true
+	}
+}
+
+func (w *Worker) nextBackoff(current time.Duration) time.Duration {
+	next := current * 2
+	if next > w.cfg.MaxIdleBackoff {
+		return w.cfg.MaxIdleBackoff
+	}
+	return next
+}
\ No newline at end of file
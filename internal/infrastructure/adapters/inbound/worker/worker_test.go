@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap/zaptest"
+)
+
+type fakeWaker struct {
+	wake      chan struct{}
+	connected bool
+}
+
+func (f *fakeWaker) Wake() <-chan struct{} { return f.wake }
+func (f *fakeWaker) Connected() bool       { return f.connected }
+
+func newTestWorker(t *testing.T, processor tasksprocessor.TasksProcessor) *Worker {
+	return newTestWorkerWithWaker(t, processor, nil, 4*time.Millisecond)
+}
+
+func newTestWorkerWithWaker(t *testing.T, processor tasksprocessor.TasksProcessor, waker Waker, maxIdle time.Duration) *Worker {
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	return NewWorker(log, processor, config.Worker{
+		BatchSize:      5,
+		PollInterval:   time.Millisecond,
+		MaxIdleBackoff: maxIdle,
+	}, waker)
+}
+
+func runWorker(t *testing.T, w *Worker) func() {
+	done := make(chan error, 1)
+	go func() { done <- w.Run() }()
+
+	return func() {
+		w.Stop(nil)
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("worker did not stop")
+		}
+	}
+}
+
+func TestWorker_ProcessesBatches(t *testing.T) {
+	processor := new(tasksprocessor.MockTasksProcessor)
+
+	var calls atomic.Int32
+	processor.On("ProcessTasks", mock.Anything, &tasksprocessor.ProcessTasksRequest{Limit: 5}).
+		Run(func(mock.Arguments) { calls.Add(1) }).
+		Return(&tasksprocessor.ProcessTasksResponse{ProcessedCount: 5, SuccessCount: 5}, nil)
+
+	stop := runWorker(t, newTestWorker(t, processor))
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+	stop()
+}
+
+func TestWorker_IdlesWhenQueueEmpty(t *testing.T) {
+	processor := new(tasksprocessor.MockTasksProcessor)
+
+	var calls atomic.Int32
+	processor.On("ProcessTasks", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { calls.Add(1) }).
+		Return(&tasksprocessor.ProcessTasksResponse{}, nil)
+
+	stop := runWorker(t, newTestWorker(t, processor))
+	assert.Eventually(t, func() bool { return calls.Load() >= 2 }, time.Second, time.Millisecond)
+	stop()
+}
+
+func TestWorker_SurvivesProcessingErrors(t *testing.T) {
+	processor := new(tasksprocessor.MockTasksProcessor)
+
+	var calls atomic.Int32
+	processor.On("ProcessTasks", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { calls.Add(1) }).
+		Return(nil, errors.New("db unavailable"))
+
+	stop := runWorker(t, newTestWorker(t, processor))
+	assert.Eventually(t, func() bool { return calls.Load() >= 2 }, time.Second, time.Millisecond)
+	stop()
+}
+
+func TestWorker_WakesOnNotification(t *testing.T) {
+	processor := new(tasksprocessor.MockTasksProcessor)
+
+	var calls atomic.Int32
+	processor.On("ProcessTasks", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { calls.Add(1) }).
+		Return(&tasksprocessor.ProcessTasksResponse{}, nil)
+
+	// The safety poll is far away, so only the notification can trigger the second call
+	waker := &fakeWaker{wake: make(chan struct{}, 1), connected: true}
+	stop := runWorker(t, newTestWorkerWithWaker(t, processor, waker, time.Hour))
+
+	assert.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int32(1), calls.Load())
+
+	waker.wake <- struct{}{}
+	assert.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond)
+	stop()
+}
+
+func TestWorker_PollsWhenWakerDisconnected(t *testing.T) {
+	processor := new(tasksprocessor.MockTasksProcessor)
+
+	var calls atomic.Int32
+	processor.On("ProcessTasks", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) { calls.Add(1) }).
+		Return(&tasksprocessor.ProcessTasksResponse{}, nil)
+
+	waker := &fakeWaker{wake: make(chan struct{}), connected: false}
+	stop := runWorker(t, newTestWorkerWithWaker(t, processor, waker, 4*time.Millisecond))
+
+	assert.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+	stop()
+}
+
+func TestWorker_NextBackoffIsCapped(t *testing.T) {
+	w := newTestWorker(t, new(tasksprocessor.MockTasksProcessor))
+
+	assert.Equal(t, 2*time.Millisecond, w.nextBackoff(time.Millisecond))
+	assert.Equal(t, 4*time.Millisecond, w.nextBackoff(2*time.Millisecond))
+	assert.Equal(t, 4*time.Millisecond, w.nextBackoff(4*time.Millisecond))
+}
\ No newline at end of file
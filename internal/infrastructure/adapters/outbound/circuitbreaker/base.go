@@ -1,19 +1,48 @@
 package circuitbreaker
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/config"
 	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
+	"task-processor/internal/infrastructure/shared/tracing"
 )
 
+// expectedErrors are regular outcomes reported by repositories (a missing row,
+// a lost race). They say nothing about the backend health and never trip a breaker.
+var expectedErrors = []error{
+	domain.ErrTaskNotFound,
+	domain.ErrTaskNotPending,
+	domain.ErrLeaseLost,
+	domain.ErrScheduleNotFound,
+	domain.ErrScheduleNameTaken,
+	domain.ErrRunNotFound,
+	domain.ErrRunFinished,
+}
+
+func isExpectedError(err error) bool {
+	for _, expected := range expectedErrors {
+		if errors.Is(err, expected) {
+			return true
+		}
+	}
+	return false
+}
+
 type BaseDecorator struct {
 	circuitBreakers map[string]*gobreaker.CircuitBreaker
 	logger          logger.Logger
+	metrics         *metrics.Metrics
 	name            string
 }
 
@@ -21,31 +50,47 @@ func NewBaseDecorator(cfg *config.Config, logger logger.Logger, name string) *Ba
 	return &BaseDecorator{
 		circuitBreakers: make(map[string]*gobreaker.CircuitBreaker),
 		logger:          logger,
+		metrics:         metrics.GetMetrics(),
 		name:            name,
 	}
 }
 
 func (d *BaseDecorator) AddCircuitBreaker(operation string, settings gobreaker.Settings) {
-	d.circuitBreakers[operation] = gobreaker.NewCircuitBreaker(settings)
+	cb := gobreaker.NewCircuitBreaker(settings)
+	d.circuitBreakers[operation] = cb
+	d.metrics.SetCircuitBreakerState(d.name, operation, cb.State())
 }
 
-func (d *BaseDecorator) ExecuteWithCB(operation string, fn func() (any, error)) (any, error) {
+// ExecuteWithCB runs fn through the breaker of operation inside a span.
+// fn receives the span context so the queries it issues are nested under it.
+func (d *BaseDecorator) ExecuteWithCB(ctx context.Context, operation string, fn func(ctx context.Context) (any, error)) (any, error) {
 	cb, exists := d.circuitBreakers[operation]
 	if !exists {
 		return nil, fmt.Errorf("circuit breaker for operation %s not found", operation)
 	}
 
+	ctx, span := tracing.Tracer().Start(ctx, d.name+"."+operation, trace.WithAttributes(
+		attribute.String("circuit_breaker.name", d.name),
+		attribute.String("circuit_breaker.state", cb.State().String()),
+	))
+	defer span.End()
+
 	d.logger.Debug("circuit breaker executing operation",
 		zap.String("name", d.name),
 		zap.String("operation", operation),
 		zap.String("state", cb.State().String()))
 
 	start := time.Now()
-	result, err := cb.Execute(fn)
+	result, err := cb.Execute(func() (any, error) {
+		return fn(ctx)
+	})
 	duration := time.Since(start)
 
 	if err != nil {
-		d.handleError(operation, err, duration, cb.State())
+		if !isExpectedError(err) {
+			tracing.RecordError(span, err)
+			d.handleError(ctx, operation, err, duration, cb.State())
+		}
 		return nil, err
 	}
 
@@ -58,22 +103,23 @@ func (d *BaseDecorator) ExecuteWithCB(operation string, fn func() (any, error))
 	return result, nil
 }
 
-func (d *BaseDecorator) handleError(operation string, err error, duration time.Duration, state gobreaker.State) {
+func (d *BaseDecorator) handleError(ctx context.Context, operation string, err error, duration time.Duration, state gobreaker.State) {
+	log := logger.WithTrace(ctx, d.logger)
 	switch err {
 	case gobreaker.ErrOpenState:
-		d.logger.Warn("circuit breaker rejected request - open state",
+		log.Warn("circuit breaker rejected request - open state",
 			zap.String("name", d.name),
 			zap.String("operation", operation),
 			zap.String("state", "open"),
 			zap.Duration("duration", duration))
 	case gobreaker.ErrTooManyRequests:
-		d.logger.Warn("circuit breaker rejected request - too many requests in half-open state",
+		log.Warn("circuit breaker rejected request - too many requests in half-open state",
 			zap.String("name", d.name),
 			zap.String("operation", operation),
 			zap.String("state", "half-open"),
 			zap.Duration("duration", duration))
 	default:
-		d.logger.Error("circuit breaker operation failed",
+		log.Error("circuit breaker operation failed",
 			zap.String("name", d.name),
 			zap.String("operation", operation),
 			zap.String("state", state.String()),
@@ -88,10 +134,14 @@ func (d *BaseDecorator) CreateSettings(cfg *config.Config, operation string) gob
 		MaxRequests: cfg.CircuitBreaker.MaxRequests,
 		Interval:    cfg.CircuitBreaker.Interval,
 		Timeout:     cfg.CircuitBreaker.Timeout,
+		IsSuccessful: func(err error) bool {
+			return err == nil || isExpectedError(err)
+		},
 		ReadyToTrip: func(counts gobreaker.Counts) bool {
 			return counts.ConsecutiveFailures > cfg.CircuitBreaker.ConsecutiveFailures
 		},
 		OnStateChange: func(name string, from gobreaker.State, to gobreaker.State) {
+			d.metrics.SetCircuitBreakerState(d.name, operation, to)
 			d.logger.Info("circuit breaker state transition",
 				zap.String("component", name),
 				zap.String("from", from.String()),
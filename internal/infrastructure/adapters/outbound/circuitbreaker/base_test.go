@@ -1,13 +1,22 @@
 package circuitbreaker
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/config"
 	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/sony/gobreaker"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
 )
@@ -28,7 +37,7 @@ func TestBaseDecorator_ExecuteWithCB_Success(t *testing.T) {
 	base.AddCircuitBreaker("op", base.CreateSettings(cfg, "op"))
 
 	// Execute a successful function through circuit breaker
-	result, err := base.ExecuteWithCB("op", func() (any, error) {
+	result, err := base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return 42, nil
 	})
 
@@ -52,13 +61,13 @@ func TestBaseDecorator_ExecuteWithCB_Error_OpensCircuit(t *testing.T) {
 	base.AddCircuitBreaker("op", base.CreateSettings(cfg, "op"))
 
 	// First call fails → should trigger circuit breaker to OPEN
-	_, err := base.ExecuteWithCB("op", func() (any, error) {
+	_, err := base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return nil, errors.New("fail")
 	})
 	assert.Error(t, err)
 
 	// Second call → CB is OPEN, should reject immediately with ErrOpenState
-	_, err = base.ExecuteWithCB("op", func() (any, error) {
+	_, err = base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return 42, nil
 	})
 	assert.ErrorIs(t, err, gobreaker.ErrOpenState)
@@ -78,7 +87,7 @@ func TestBaseDecorator_ExecuteWithCB_HalfOpenToClosed(t *testing.T) {
 	base.AddCircuitBreaker("op", base.CreateSettings(cfg, "op"))
 
 	// 1. First call fails → circuit goes Open
-	_, err := base.ExecuteWithCB("op", func() (any, error) {
+	_, err := base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return nil, errors.New("fail")
 	})
 	assert.Error(t, err)
@@ -87,13 +96,13 @@ func TestBaseDecorator_ExecuteWithCB_HalfOpenToClosed(t *testing.T) {
 	time.Sleep(150 * time.Millisecond)
 
 	// 3. Next call succeeds → circuit should move back to Closed
-	_, err = base.ExecuteWithCB("op", func() (any, error) {
+	_, err = base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return 42, nil
 	})
 	assert.NoError(t, err)
 
 	// 4. Another call should also succeed (circuit now Closed again)
-	_, err = base.ExecuteWithCB("op", func() (any, error) {
+	_, err = base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return 99, nil
 	})
 	assert.NoError(t, err)
@@ -113,7 +122,7 @@ func TestBaseDecorator_ExecuteWithCB_HalfOpenToOpen(t *testing.T) {
 	base.AddCircuitBreaker("op", base.CreateSettings(cfg, "op"))
 
 	// 1. First call fails → breaker goes to Open
-	_, err := base.ExecuteWithCB("op", func() (any, error) {
+	_, err := base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return nil, errors.New("fail")
 	})
 	assert.Error(t, err)
@@ -122,14 +131,105 @@ func TestBaseDecorator_ExecuteWithCB_HalfOpenToOpen(t *testing.T) {
 	time.Sleep(150 * time.Millisecond)
 
 	// 3. Trial call fails again → breaker transitions Half-Open → Open
-	_, err = base.ExecuteWithCB("op", func() (any, error) {
+	_, err = base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return nil, errors.New("fail again")
 	})
 	assert.Error(t, err)
 
 	// 4. Another call should be rejected immediately (breaker still Open)
-	_, err = base.ExecuteWithCB("op", func() (any, error) {
+	_, err = base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
 		return 123, nil
 	})
 	assert.ErrorIs(t, err, gobreaker.ErrOpenState)
+}
+
+func TestBaseDecorator_ExecuteWithCB_ExpectedErrorKeepsCircuitClosed(t *testing.T) {
+	// Setup configuration with 0 consecutive failure threshold
+	cfg := &config.Config{
+		CircuitBreaker: config.CircuitBreaker{
+			MaxRequests:         1,
+			Timeout:             time.Second,
+			Interval:            time.Second,
+			ConsecutiveFailures: 0,
+		},
+	}
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+	base := NewBaseDecorator(cfg, log, "test-component")
+	base.AddCircuitBreaker("op", base.CreateSettings(cfg, "op"))
+
+	// A missing row is returned to the caller but is not a backend failure
+	_, err := base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
+		return nil, fmt.Errorf("lookup: %w", domain.ErrTaskNotFound)
+	})
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+
+	// CB stays CLOSED, so the next call goes through
+	result, err := base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
+		return 42, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, result)
+}
+
+func TestBaseDecorator_StateMetric(t *testing.T) {
+	cfg := &config.Config{
+		CircuitBreaker: config.CircuitBreaker{
+			MaxRequests:         1,
+			Timeout:             time.Minute,
+			Interval:            time.Minute,
+			ConsecutiveFailures: 1,
+		},
+	}
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+
+	base := NewBaseDecorator(cfg, log, "state-metric-component")
+	base.AddCircuitBreaker("op", base.CreateSettings(cfg, "op"))
+	state := metrics.GetMetrics().CircuitBreakerState.WithLabelValues("state-metric-component", "op")
+
+	assert.Equal(t, float64(gobreaker.StateClosed), testutil.ToFloat64(state))
+
+	// Two consecutive failures exceed the threshold of one and open the breaker
+	for range 2 {
+		_, _ = base.ExecuteWithCB(context.Background(), "op", func(context.Context) (any, error) {
+			return nil, errors.New("backend down")
+		})
+	}
+
+	assert.Equal(t, float64(gobreaker.StateOpen), testutil.ToFloat64(state))
+}
+
+func TestBaseDecorator_ExecuteWithCB_Span(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	cfg := &config.Config{
+		CircuitBreaker: config.CircuitBreaker{
+			MaxRequests:         1,
+			Timeout:             time.Second,
+			Interval:            time.Second,
+			ConsecutiveFailures: 2,
+		},
+	}
+	log := &logger.ZapLogger{Logger: zaptest.NewLogger(t)}
+
+	base := NewBaseDecorator(cfg, log, "span-component")
+	base.AddCircuitBreaker("op", base.CreateSettings(cfg, "op"))
+
+	var innerSpan trace.SpanContext
+	_, err := base.ExecuteWithCB(context.Background(), "op", func(ctx context.Context) (any, error) {
+		innerSpan = trace.SpanContextFromContext(ctx)
+		return nil, errors.New("backend down")
+	})
+	assert.Error(t, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "span-component.op", spans[0].Name())
+		// fn runs under the span, so its queries are nested in it
+		assert.Equal(t, spans[0].SpanContext().SpanID(), innerSpan.SpanID())
+		assert.Len(t, spans[0].Events(), 1, "the error is recorded on the span")
+	}
 }
\ No newline at end of file
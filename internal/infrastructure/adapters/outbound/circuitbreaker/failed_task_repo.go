@@ -2,11 +2,14 @@ package circuitbreaker
 
 import (
 	"context"
+	"errors"
 	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
 	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/config"
 	"task-processor/internal/infrastructure/shared/logger"
 
+	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 type FailedTaskRepoDecorator struct {
@@ -22,7 +25,9 @@ func NewFailedTaskRepoDecorator(
 ) *FailedTaskRepoDecorator {
 	
 	base := NewBaseDecorator(cfg, logger, name)
-	base.AddCircuitBreaker("Create", base.CreateSettings(cfg, "Create"))
+	for _, op := range []string{"Create", "Get", "List", "Remove", "RemoveMatching", "Count"} {
+		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
+	}
 
 	return &FailedTaskRepoDecorator{
 		repository: repository,
@@ -31,8 +36,103 @@ func NewFailedTaskRepoDecorator(
 }
 
 func (d *FailedTaskRepoDecorator) Create(ctx context.Context, task *domain.Task) error {
-	_, err := d.base.ExecuteWithCB("Create", func() (any, error) {
+	_, err := d.base.ExecuteWithCB(ctx, "Create", func(ctx context.Context) (any, error) {
 		return nil, d.repository.Create(ctx, task)
 	})
 	return err
+}
+
+func (d *FailedTaskRepoDecorator) Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Get", func(ctx context.Context) (any, error) {
+		return d.repository.Get(ctx, taskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	task, ok := result.(*domain.Task)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Get"),
+			zap.String("expected", "*domain.Task"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return task, nil
+}
+
+func (d *FailedTaskRepoDecorator) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "List", func(ctx context.Context) (any, error) {
+		return d.repository.List(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, ok := result.([]*domain.Task)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "List"),
+			zap.String("expected", "[]*domain.Task"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return tasks, nil
+}
+
+func (d *FailedTaskRepoDecorator) Remove(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Remove", func(ctx context.Context) (any, error) {
+		return d.repository.Remove(ctx, taskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	task, ok := result.(*domain.Task)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Remove"),
+			zap.String("expected", "*domain.Task"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return task, nil
+}
+
+func (d *FailedTaskRepoDecorator) RemoveMatching(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "RemoveMatching", func(ctx context.Context) (any, error) {
+		return d.repository.RemoveMatching(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, ok := result.([]*domain.Task)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "RemoveMatching"),
+			zap.String("expected", "[]*domain.Task"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return tasks, nil
+}
+
+func (d *FailedTaskRepoDecorator) Count(ctx context.Context) (int, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Count", func(ctx context.Context) (any, error) {
+		return d.repository.Count(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	count, ok := result.(int)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Count"),
+			zap.String("expected", "int"))
+		return 0, errors.New("type assertion error")
+	}
+
+	return count, nil
 }
\ No newline at end of file
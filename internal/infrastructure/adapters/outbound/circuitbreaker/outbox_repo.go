@@ -0,0 +1,95 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type OutboxRepoDecorator struct {
+	repository outboxrepo.OutboxRepository
+	base       *BaseDecorator
+}
+
+func NewOutboxRepoDecorator(
+	repository outboxrepo.OutboxRepository,
+	cfg 	  *config.Config,
+	logger    logger.Logger,
+	name       string,
+) *OutboxRepoDecorator {
+
+	base := NewBaseDecorator(cfg, logger, name)
+	for _, op := range []string{"Append", "Claim", "MarkPublished", "Release", "DeletePublishedBefore"} {
+		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
+	}
+
+	return &OutboxRepoDecorator{
+		repository: repository,
+		base:       base,
+	}
+}
+
+func (d *OutboxRepoDecorator) Append(ctx context.Context, messages []*domain.OutboxMessage) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Append", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Append(ctx, messages)
+	})
+	return err
+}
+
+func (d *OutboxRepoDecorator) Claim(ctx context.Context, limit int, lease time.Duration) ([]*domain.OutboxMessage, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Claim", func(ctx context.Context) (any, error) {
+		return d.repository.Claim(ctx, limit, lease)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages, ok := result.([]*domain.OutboxMessage)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Claim"),
+			zap.String("expected", "[]*domain.OutboxMessage"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return messages, nil
+}
+
+func (d *OutboxRepoDecorator) MarkPublished(ctx context.Context, ids []int64) error {
+	_, err := d.base.ExecuteWithCB(ctx, "MarkPublished", func(ctx context.Context) (any, error) {
+		return nil, d.repository.MarkPublished(ctx, ids)
+	})
+	return err
+}
+
+func (d *OutboxRepoDecorator) Release(ctx context.Context, ids []int64) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Release", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Release(ctx, ids)
+	})
+	return err
+}
+
+func (d *OutboxRepoDecorator) DeletePublishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "DeletePublishedBefore", func(ctx context.Context) (any, error) {
+		return d.repository.DeletePublishedBefore(ctx, cutoff, limit)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, ok := result.(int)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "DeletePublishedBefore"),
+			zap.String("expected", "int"))
+		return 0, errors.New("type assertion error")
+	}
+
+	return deleted, nil
+}
\ No newline at end of file
@@ -0,0 +1,115 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/processingrunrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ProcessingRunRepoDecorator struct {
+	repository processingrunrepo.ProcessingRunRepository
+	base       *BaseDecorator
+}
+
+func NewProcessingRunRepoDecorator(
+	repository processingrunrepo.ProcessingRunRepository,
+	cfg 	  *config.Config,
+	logger    logger.Logger,
+	name       string,
+) *ProcessingRunRepoDecorator {
+
+	base := NewBaseDecorator(cfg, logger, name)
+	for _, op := range []string{"Create", "Get", "AddProgress", "Finish", "FailStale", "DeleteFinishedBefore"} {
+		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
+	}
+
+	return &ProcessingRunRepoDecorator{
+		repository: repository,
+		base:       base,
+	}
+}
+
+func (d *ProcessingRunRepoDecorator) Create(ctx context.Context, run *domain.ProcessingRun) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Create", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Create(ctx, run)
+	})
+	return err
+}
+
+func (d *ProcessingRunRepoDecorator) Get(ctx context.Context, runID uuid.UUID) (*domain.ProcessingRun, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Get", func(ctx context.Context) (any, error) {
+		return d.repository.Get(ctx, runID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	run, ok := result.(*domain.ProcessingRun)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Get"),
+			zap.String("expected", "*domain.ProcessingRun"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return run, nil
+}
+
+func (d *ProcessingRunRepoDecorator) AddProgress(ctx context.Context, runID uuid.UUID, delta domain.RunCounters) error {
+	_, err := d.base.ExecuteWithCB(ctx, "AddProgress", func(ctx context.Context) (any, error) {
+		return nil, d.repository.AddProgress(ctx, runID, delta)
+	})
+	return err
+}
+
+func (d *ProcessingRunRepoDecorator) Finish(ctx context.Context, runID uuid.UUID, status domain.RunStatus, errorMessage string) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Finish", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Finish(ctx, runID, status, errorMessage)
+	})
+	return err
+}
+
+func (d *ProcessingRunRepoDecorator) FailStale(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "FailStale", func(ctx context.Context) (any, error) {
+		return d.repository.FailStale(ctx, staleAfter, limit)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	failed, ok := result.(int)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "FailStale"),
+			zap.String("expected", "int"))
+		return 0, errors.New("type assertion error")
+	}
+
+	return failed, nil
+}
+
+func (d *ProcessingRunRepoDecorator) DeleteFinishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "DeleteFinishedBefore", func(ctx context.Context) (any, error) {
+		return d.repository.DeleteFinishedBefore(ctx, cutoff, limit)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, ok := result.(int)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "DeleteFinishedBefore"),
+			zap.String("expected", "int"))
+		return 0, errors.New("type assertion error")
+	}
+
+	return deleted, nil
+}
\ No newline at end of file
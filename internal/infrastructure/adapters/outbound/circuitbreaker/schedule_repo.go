@@ -0,0 +1,126 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type ScheduleRepoDecorator struct {
+	repository schedulerepo.ScheduleRepository
+	base       *BaseDecorator
+}
+
+func NewScheduleRepoDecorator(
+	repository schedulerepo.ScheduleRepository,
+	cfg 	  *config.Config,
+	logger    logger.Logger,
+	name       string,
+) *ScheduleRepoDecorator {
+
+	base := NewBaseDecorator(cfg, logger, name)
+
+	operations := []string{
+		"Create", "Get", "List", "Update", "Delete", "ListDue", "MarkFired",
+	}
+	for _, op := range operations {
+		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
+	}
+
+	return &ScheduleRepoDecorator{
+		repository: repository,
+		base:       base,
+	}
+}
+
+func (d *ScheduleRepoDecorator) Create(ctx context.Context, schedule *domain.Schedule) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Create", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Create(ctx, schedule)
+	})
+	return err
+}
+
+func (d *ScheduleRepoDecorator) Get(ctx context.Context, id uuid.UUID) (*domain.Schedule, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Get", func(ctx context.Context) (any, error) {
+		return d.repository.Get(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, ok := result.(*domain.Schedule)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Get"),
+			zap.String("expected", "*domain.Schedule"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return schedule, nil
+}
+
+func (d *ScheduleRepoDecorator) List(ctx context.Context) ([]*domain.Schedule, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "List", func(ctx context.Context) (any, error) {
+		return d.repository.List(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, ok := result.([]*domain.Schedule)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "List"),
+			zap.String("expected", "[]*domain.Schedule"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return schedules, nil
+}
+
+func (d *ScheduleRepoDecorator) Update(ctx context.Context, schedule *domain.Schedule) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Update", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Update(ctx, schedule)
+	})
+	return err
+}
+
+func (d *ScheduleRepoDecorator) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Delete", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Delete(ctx, id)
+	})
+	return err
+}
+
+func (d *ScheduleRepoDecorator) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "ListDue", func(ctx context.Context) (any, error) {
+		return d.repository.ListDue(ctx, now, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, ok := result.([]*domain.Schedule)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "ListDue"),
+			zap.String("expected", "[]*domain.Schedule"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return schedules, nil
+}
+
+func (d *ScheduleRepoDecorator) MarkFired(ctx context.Context, id uuid.UUID, lastRunAt, nextRunAt time.Time) error {
+	_, err := d.base.ExecuteWithCB(ctx, "MarkFired", func(ctx context.Context) (any, error) {
+		return nil, d.repository.MarkFired(ctx, id, lastRunAt, nextRunAt)
+	})
+	return err
+}
\ No newline at end of file
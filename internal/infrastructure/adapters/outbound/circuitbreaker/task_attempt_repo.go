@@ -0,0 +1,62 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TaskAttemptRepoDecorator struct {
+	repository taskattemptrepo.TaskAttemptRepository
+	base       *BaseDecorator
+}
+
+func NewTaskAttemptRepoDecorator(
+	repository taskattemptrepo.TaskAttemptRepository,
+	cfg 	  *config.Config,
+	logger    logger.Logger,
+	name       string,
+) *TaskAttemptRepoDecorator {
+
+	base := NewBaseDecorator(cfg, logger, name)
+	for _, op := range []string{"Create", "ListByTask"} {
+		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
+	}
+
+	return &TaskAttemptRepoDecorator{
+		repository: repository,
+		base:       base,
+	}
+}
+
+func (d *TaskAttemptRepoDecorator) Create(ctx context.Context, attempt *domain.TaskAttempt) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Create", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Create(ctx, attempt)
+	})
+	return err
+}
+
+func (d *TaskAttemptRepoDecorator) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskAttempt, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "ListByTask", func(ctx context.Context) (any, error) {
+		return d.repository.ListByTask(ctx, taskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, ok := result.([]*domain.TaskAttempt)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "ListByTask"),
+			zap.String("expected", "[]*domain.TaskAttempt"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return attempts, nil
+}
\ No newline at end of file
@@ -0,0 +1,75 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type TaskEventRepoDecorator struct {
+	repository taskeventrepo.TaskEventRepository
+	base       *BaseDecorator
+}
+
+func NewTaskEventRepoDecorator(
+	repository taskeventrepo.TaskEventRepository,
+	cfg 	  *config.Config,
+	logger    logger.Logger,
+	name       string,
+) *TaskEventRepoDecorator {
+
+	base := NewBaseDecorator(cfg, logger, name)
+	for _, op := range []string{"ListByTask", "DeleteOlderThan"} {
+		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
+	}
+
+	return &TaskEventRepoDecorator{
+		repository: repository,
+		base:       base,
+	}
+}
+
+func (d *TaskEventRepoDecorator) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskEvent, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "ListByTask", func(ctx context.Context) (any, error) {
+		return d.repository.ListByTask(ctx, taskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events, ok := result.([]*domain.TaskEvent)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "ListByTask"),
+			zap.String("expected", "[]*domain.TaskEvent"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return events, nil
+}
+
+func (d *TaskEventRepoDecorator) DeleteOlderThan(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "DeleteOlderThan", func(ctx context.Context) (any, error) {
+		return d.repository.DeleteOlderThan(ctx, cutoff, limit)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	count, ok := result.(int)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "DeleteOlderThan"),
+			zap.String("expected", "int"))
+		return 0, errors.New("type assertion error")
+	}
+
+	return count, nil
+}
\ No newline at end of file
@@ -2,11 +2,13 @@ package circuitbreaker
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
 	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/config"
 	"task-processor/internal/infrastructure/shared/logger"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -26,7 +28,10 @@ func NewTaskRepoDecorator(
 	
 	base := NewBaseDecorator(cfg, logger, name)
 	
-	operations := []string{"BatchCreate", "AcquireTasks", "MarkAsProcessed", "MarkAsFailed", "Delete"}
+	operations := []string{
+		"BatchCreate", "AcquireTasks", "ExtendLease", "ReleaseLease", "ReleaseExpiredLeases",
+		"MarkAsProcessed", "MarkAsFailed", "Reschedule", "Restore", "Get", "List", "Delete", "DeleteExhausted", "Stats",
+	}
 	for _, op := range operations {
 		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
 	}
@@ -38,7 +43,7 @@ func NewTaskRepoDecorator(
 }
 
 func (d *TaskRepoDecorator) BatchCreate(ctx context.Context, tasks []*domain.Task) ([]uuid.UUID, error) {
-	result, err := d.base.ExecuteWithCB("BatchCreate", func() (any, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "BatchCreate", func(ctx context.Context) (any, error) {
 		return d.repository.BatchCreate(ctx, tasks)
 	})
 	if err != nil {
@@ -56,9 +61,9 @@ func (d *TaskRepoDecorator) BatchCreate(ctx context.Context, tasks []*domain.Tas
 	return ids, nil
 }
 
-func (d *TaskRepoDecorator) AcquireTasks(ctx context.Context, limit int) ([]*domain.Task, error) {
-	result, err := d.base.ExecuteWithCB("AcquireTasks", func() (any, error) {
-		return d.repository.AcquireTasks(ctx, limit)
+func (d *TaskRepoDecorator) AcquireTasks(ctx context.Context, limit int, lease domain.Lease) ([]*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "AcquireTasks", func(ctx context.Context) (any, error) {
+		return d.repository.AcquireTasks(ctx, limit, lease)
 	})
 	if err != nil {
 		return nil, err
@@ -75,23 +80,146 @@ func (d *TaskRepoDecorator) AcquireTasks(ctx context.Context, limit int) ([]*dom
 	return tasks, nil
 }
 
-func (d *TaskRepoDecorator) MarkAsProcessed(ctx context.Context, taskID uuid.UUID) error {
-	_, err := d.base.ExecuteWithCB("MarkAsProcessed", func() (any, error) {
-		return nil, d.repository.MarkAsProcessed(ctx, taskID)
+func (d *TaskRepoDecorator) ExtendLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	_, err := d.base.ExecuteWithCB(ctx, "ExtendLease", func(ctx context.Context) (any, error) {
+		return nil, d.repository.ExtendLease(ctx, taskID, lease)
 	})
 	return err
 }
 
-func (d *TaskRepoDecorator) MarkAsFailed(ctx context.Context, taskID uuid.UUID, errorMsg string) error {
-	_, err := d.base.ExecuteWithCB("MarkAsFailed", func() (any, error) {
-		return nil, d.repository.MarkAsFailed(ctx, taskID, errorMsg)
+func (d *TaskRepoDecorator) ReleaseLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	_, err := d.base.ExecuteWithCB(ctx, "ReleaseLease", func(ctx context.Context) (any, error) {
+		return nil, d.repository.ReleaseLease(ctx, taskID, lease)
 	})
 	return err
 }
 
-func (d *TaskRepoDecorator) Delete(ctx context.Context, taskID uuid.UUID) error {
-	_, err := d.base.ExecuteWithCB("Delete", func() (any, error) {
-		return nil, d.repository.Delete(ctx, taskID)
+func (d *TaskRepoDecorator) ReleaseExpiredLeases(ctx context.Context, limit int, retryAfter func(attempts int) time.Duration) (int, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "ReleaseExpiredLeases", func(ctx context.Context) (any, error) {
+		return d.repository.ReleaseExpiredLeases(ctx, limit, retryAfter)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	released, ok := result.(int)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "ReleaseExpiredLeases"),
+			zap.String("expected", "int"))
+		return 0, errors.New("type assertion error")
+	}
+
+	return released, nil
+}
+
+func (d *TaskRepoDecorator) MarkAsProcessed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, result json.RawMessage) error {
+	_, err := d.base.ExecuteWithCB(ctx, "MarkAsProcessed", func(ctx context.Context) (any, error) {
+		return nil, d.repository.MarkAsProcessed(ctx, taskID, lease, result)
 	})
 	return err
+}
+
+func (d *TaskRepoDecorator) MarkAsFailed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, errorMsg string, retryAfter time.Duration) error {
+	_, err := d.base.ExecuteWithCB(ctx, "MarkAsFailed", func(ctx context.Context) (any, error) {
+		return nil, d.repository.MarkAsFailed(ctx, taskID, lease, errorMsg, retryAfter)
+	})
+	return err
+}
+
+func (d *TaskRepoDecorator) Reschedule(ctx context.Context, taskID uuid.UUID, runAt time.Time) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Reschedule", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Reschedule(ctx, taskID, runAt)
+	})
+	return err
+}
+
+func (d *TaskRepoDecorator) Restore(ctx context.Context, tasks []*domain.Task) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Restore", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Restore(ctx, tasks)
+	})
+	return err
+}
+
+func (d *TaskRepoDecorator) Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Get", func(ctx context.Context) (any, error) {
+		return d.repository.Get(ctx, taskID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	task, ok := result.(*domain.Task)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Get"),
+			zap.String("expected", "*domain.Task"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return task, nil
+}
+
+func (d *TaskRepoDecorator) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "List", func(ctx context.Context) (any, error) {
+		return d.repository.List(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, ok := result.([]*domain.Task)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "List"),
+			zap.String("expected", "[]*domain.Task"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return tasks, nil
+}
+
+func (d *TaskRepoDecorator) Delete(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Delete", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Delete(ctx, taskID, lease)
+	})
+	return err
+}
+
+func (d *TaskRepoDecorator) DeleteExhausted(ctx context.Context, limit int) ([]*domain.Task, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "DeleteExhausted", func(ctx context.Context) (any, error) {
+		return d.repository.DeleteExhausted(ctx, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, ok := result.([]*domain.Task)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "DeleteExhausted"),
+			zap.String("expected", "[]*domain.Task"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return tasks, nil
+}
+
+func (d *TaskRepoDecorator) Stats(ctx context.Context) (*domain.QueueStats, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "Stats", func(ctx context.Context) (any, error) {
+		return d.repository.Stats(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats, ok := result.(*domain.QueueStats)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "Stats"),
+			zap.String("expected", "*domain.QueueStats"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return stats, nil
 }
\ No newline at end of file
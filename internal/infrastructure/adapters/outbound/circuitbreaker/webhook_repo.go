@@ -0,0 +1,83 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type WebhookRepoDecorator struct {
+	repository webhookrepo.WebhookDeliveryRepository
+	base       *BaseDecorator
+}
+
+func NewWebhookRepoDecorator(
+	repository webhookrepo.WebhookDeliveryRepository,
+	cfg 	  *config.Config,
+	logger    logger.Logger,
+	name       string,
+) *WebhookRepoDecorator {
+
+	base := NewBaseDecorator(cfg, logger, name)
+	for _, op := range []string{"Create", "ClaimDue", "MarkDelivered", "MarkFailed", "MarkAbandoned"} {
+		base.AddCircuitBreaker(op, base.CreateSettings(cfg, op))
+	}
+
+	return &WebhookRepoDecorator{
+		repository: repository,
+		base:       base,
+	}
+}
+
+func (d *WebhookRepoDecorator) Create(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	_, err := d.base.ExecuteWithCB(ctx, "Create", func(ctx context.Context) (any, error) {
+		return nil, d.repository.Create(ctx, delivery)
+	})
+	return err
+}
+
+func (d *WebhookRepoDecorator) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	result, err := d.base.ExecuteWithCB(ctx, "ClaimDue", func(ctx context.Context) (any, error) {
+		return d.repository.ClaimDue(ctx, limit, lease)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, ok := result.([]*domain.WebhookDelivery)
+	if !ok {
+		d.base.logger.Error("type assertion failed",
+			zap.String("operation", "ClaimDue"),
+			zap.String("expected", "[]*domain.WebhookDelivery"))
+		return nil, errors.New("type assertion error")
+	}
+
+	return deliveries, nil
+}
+
+func (d *WebhookRepoDecorator) MarkDelivered(ctx context.Context, id int64, statusCode int) error {
+	_, err := d.base.ExecuteWithCB(ctx, "MarkDelivered", func(ctx context.Context) (any, error) {
+		return nil, d.repository.MarkDelivered(ctx, id, statusCode)
+	})
+	return err
+}
+
+func (d *WebhookRepoDecorator) MarkFailed(ctx context.Context, id int64, statusCode int, message string, retryAfter time.Duration) error {
+	_, err := d.base.ExecuteWithCB(ctx, "MarkFailed", func(ctx context.Context) (any, error) {
+		return nil, d.repository.MarkFailed(ctx, id, statusCode, message, retryAfter)
+	})
+	return err
+}
+
+func (d *WebhookRepoDecorator) MarkAbandoned(ctx context.Context, id int64, statusCode int, message string) error {
+	_, err := d.base.ExecuteWithCB(ctx, "MarkAbandoned", func(ctx context.Context) (any, error) {
+		return nil, d.repository.MarkAbandoned(ctx, id, statusCode, message)
+	})
+	return err
+}
\ No newline at end of file
@@ -0,0 +1,45 @@
+package outboxsink
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"task-processor/internal/application/ports/outbound/outboxsink"
+	"task-processor/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStreamSink appends messages to a Redis stream. The outbox ID travels in
+// the "id" field, consumers deduplicate redeliveries on it.
+type RedisStreamSink struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+func NewRedisStreamSink(client *redis.Client, stream string, maxLen int64) outboxsink.Sink {
+	return &RedisStreamSink{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+func (s *RedisStreamSink) Publish(ctx context.Context, message *domain.OutboxMessage) error {
+	err := s.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: s.stream,
+		MaxLen: s.maxLen,
+		Approx: s.maxLen > 0,
+		Values: map[string]any{
+			"id":      strconv.FormatInt(message.ID, 10),
+			"task_id": message.TaskID.String(),
+			"event":   string(message.Event),
+			"payload": string(message.Payload),
+		},
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to append to stream %s: %w", s.stream, err)
+	}
+	return nil
+}
\ No newline at end of file
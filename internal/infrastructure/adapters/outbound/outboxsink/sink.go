@@ -0,0 +1,27 @@
+package outboxsink
+
+import (
+	"fmt"
+	"os"
+	"task-processor/internal/application/ports/outbound/outboxsink"
+	"task-processor/internal/infrastructure/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	SinkRedis  = "redis"
+	SinkStdout = "stdout"
+)
+
+// New returns the sink selected by the configuration
+func New(cfg config.Outbox, client *redis.Client) (outboxsink.Sink, error) {
+	switch cfg.Sink {
+	case SinkRedis:
+		return NewRedisStreamSink(client, cfg.Stream, cfg.StreamMaxLen), nil
+	case SinkStdout:
+		return NewStdoutSink(os.Stdout), nil
+	default:
+		return nil, fmt.Errorf("unknown outbox sink %q", cfg.Sink)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,54 @@
+package outboxsink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"task-processor/internal/application/ports/outbound/outboxsink"
+	"task-processor/internal/domain"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// line is the JSON document written for each message
+type line struct {
+	ID        int64                `json:"id"`
+	TaskID    uuid.UUID            `json:"task_id"`
+	Event     domain.TaskEventType `json:"event"`
+	Payload   json.RawMessage      `json:"payload"`
+	CreatedAt time.Time            `json:"created_at"`
+}
+
+// StdoutSink writes messages as JSON lines, meant for local development.
+// It takes any writer so tests can capture the output.
+type StdoutSink struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func NewStdoutSink(out io.Writer) outboxsink.Sink {
+	return &StdoutSink{out: out}
+}
+
+func (s *StdoutSink) Publish(_ context.Context, message *domain.OutboxMessage) error {
+	data, err := json.Marshal(line{
+		ID:        message.ID,
+		TaskID:    message.TaskID,
+		Event:     message.Event,
+		Payload:   message.Payload,
+		CreatedAt: message.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox message: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.out.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write outbox message: %w", err)
+	}
+	return nil
+}
\ No newline at end of file
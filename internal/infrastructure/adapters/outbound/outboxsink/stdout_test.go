@@ -0,0 +1,49 @@
+package outboxsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/config"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdoutSink_WritesJSONLines(t *testing.T) {
+	var out bytes.Buffer
+	sink := NewStdoutSink(&out)
+
+	taskID := uuid.New()
+	createdAt := time.Date(2025, 5, 1, 8, 30, 0, 0, time.UTC)
+	for id := int64(1); id <= 2; id++ {
+		require.NoError(t, sink.Publish(context.Background(), &domain.OutboxMessage{
+			ID:        id,
+			TaskID:    taskID,
+			Event:     domain.EventProcessed,
+			Payload:   json.RawMessage(`{"status":"PROCESSED"}`),
+			CreatedAt: createdAt,
+		}))
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var first line
+	require.NoError(t, json.Unmarshal(lines[0], &first))
+	assert.Equal(t, int64(1), first.ID)
+	assert.Equal(t, taskID, first.TaskID)
+	assert.Equal(t, domain.EventProcessed, first.Event)
+	assert.JSONEq(t, `{"status":"PROCESSED"}`, string(first.Payload))
+	assert.True(t, createdAt.Equal(first.CreatedAt))
+}
+
+func TestNew_UnknownSink(t *testing.T) {
+	_, err := New(config.Outbox{Sink: "kafka"}, nil)
+
+	assert.Error(t, err)
+}
\ No newline at end of file
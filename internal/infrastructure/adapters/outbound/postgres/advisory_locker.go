@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdvisoryLocker implements locker.Locker with transaction-scoped advisory locks
+type AdvisoryLocker struct {
+	pool *pgxpool.Pool
+}
+
+// NewAdvisoryLocker creates new locker instance
+func NewAdvisoryLocker(pool *pgxpool.Pool) locker.Locker {
+	return &AdvisoryLocker{pool: pool}
+}
+
+// TryLock takes pg_try_advisory_xact_lock(key) in the transaction from ctx
+func (l *AdvisoryLocker) TryLock(ctx context.Context, key int64) (bool, error) {
+	// Outside a transaction the lock would be released as soon as the statement ends
+	if _, ok := txManager.GetTx(ctx); !ok {
+		return false, errors.New("advisory lock requires a transaction")
+	}
+	querier := txManager.GetQuerier(ctx, l.pool)
+
+	var locked bool
+	if err := querier.QueryRow(ctx, `SELECT pg_try_advisory_xact_lock($1)`, key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+	return locked, nil
+}
\ No newline at end of file
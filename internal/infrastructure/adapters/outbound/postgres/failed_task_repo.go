@@ -2,14 +2,22 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
 	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// failedTaskColumns lists the failed_tasks columns in the order expected by scanFailedTask
+const failedTaskColumns = `
+	id, type, payload, status, priority, created_at, updated_at,
+	attempts, max_attempts, error_message, moved_at, callback_url`
+
 // TaskRepo implements persistence.FailedTaskRepository
 type FailedTaskRepo struct {
 	pool *pgxpool.Pool
@@ -25,13 +33,151 @@ func (r *FailedTaskRepo) Create(ctx context.Context, task *domain.Task) error {
 	querier := txManager.GetQuerier(ctx, r.pool)
 
 	_, err := querier.Exec(ctx, `
-		INSERT INTO failed_tasks (
-			id, status, created_at, updated_at, attempts, max_attempts, error_message
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
-		ON CONFLICT (id) DO NOTHING
-	`, task.ID, task.Status, task.CreatedAt, task.UpdatedAt, task.Attempts, task.MaxAttempts, task.ErrorMessage)
+		WITH moved AS (
+			INSERT INTO failed_tasks (
+				id, type, payload, priority, status, created_at, updated_at, attempts, max_attempts, error_message, callback_url
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $12)
+			ON CONFLICT (id) DO NOTHING
+			RETURNING id, attempts, error_message
+		)
+		INSERT INTO task_events (task_id, type, attempt, message)
+		SELECT id, $11, attempts, error_message FROM moved
+	`, task.ID, task.Type, task.Payload, task.Priority, task.Status, task.CreatedAt, task.UpdatedAt, task.Attempts, task.MaxAttempts, task.ErrorMessage, domain.EventDeadLettered, nullableString(task.CallbackURL))
 	if err != nil {
 		return fmt.Errorf("failed to insert into failed_tasks: %w", err)
 	}
 	return nil
+}
+
+// Get returns a dead-lettered task by ID
+func (r *FailedTaskRepo) Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	row := querier.QueryRow(ctx, `SELECT `+failedTaskColumns+` FROM failed_tasks WHERE id = $1`, taskID)
+	task, err := scanFailedTask(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get failed task: %w", err)
+	}
+	return task, nil
+}
+
+// List returns up to filter.Limit dead-lettered tasks matching filter, newest first
+func (r *FailedTaskRepo) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	where := newTaskFilterQuery(filter)
+	query := `SELECT ` + failedTaskColumns + ` FROM failed_tasks` + where.clause() +
+		` ORDER BY created_at DESC, id DESC LIMIT ` + where.arg(filter.Limit)
+
+	rows, err := querier.Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed tasks: %w", err)
+	}
+
+	return scanFailedTasks(rows, filter.Limit)
+}
+
+// Count returns the number of dead-lettered tasks
+func (r *FailedTaskRepo) Count(ctx context.Context) (int, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	var count int
+	if err := querier.QueryRow(ctx, `SELECT COUNT(*) FROM failed_tasks`).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count failed tasks: %w", err)
+	}
+	return count, nil
+}
+
+// Remove deletes a dead-lettered task and returns it
+func (r *FailedTaskRepo) Remove(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	row := querier.QueryRow(ctx, `DELETE FROM failed_tasks WHERE id = $1 RETURNING `+failedTaskColumns, taskID)
+	task, err := scanFailedTask(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove failed task: %w", err)
+	}
+	return task, nil
+}
+
+// RemoveMatching deletes up to filter.Limit dead-lettered tasks matching filter,
+// oldest first, and returns them. Rows locked by a concurrent removal are skipped.
+func (r *FailedTaskRepo) RemoveMatching(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	where := newTaskFilterQuery(filter)
+	query := `
+		DELETE FROM failed_tasks
+		WHERE id IN (
+			SELECT id FROM failed_tasks` + where.clause() + `
+			ORDER BY created_at ASC, id ASC
+			LIMIT ` + where.arg(filter.Limit) + `
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + failedTaskColumns
+
+	rows, err := querier.Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove failed tasks: %w", err)
+	}
+
+	return scanFailedTasks(rows, filter.Limit)
+}
+
+// scanFailedTask reads a single row selected with failedTaskColumns
+func scanFailedTask(row pgx.Row) (*domain.Task, error) {
+	var task domain.Task
+	var errorMsg *string
+	var callbackURL *string
+
+	err := row.Scan(
+		&task.ID,
+		&task.Type,
+		&task.Payload,
+		&task.Status,
+		&task.Priority,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+		&task.Attempts,
+		&task.MaxAttempts,
+		&errorMsg,
+		&task.MovedAt,
+		&callbackURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if errorMsg != nil {
+		task.ErrorMessage = *errorMsg
+	}
+	if callbackURL != nil {
+		task.CallbackURL = *callbackURL
+	}
+	return &task, nil
+}
+
+// scanFailedTasks reads all rows selected with failedTaskColumns
+func scanFailedTasks(rows pgx.Rows, capacity int) ([]*domain.Task, error) {
+	defer rows.Close()
+
+	tasks := make([]*domain.Task, 0, capacity)
+	for rows.Next() {
+		task, err := scanFailedTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan failed task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through failed tasks: %w", err)
+	}
+
+	return tasks, nil
 }
\ No newline at end of file
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"task-processor/internal/infrastructure/shared/logger"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// TasksCreatedChannel is the NOTIFY channel signalled whenever new tasks are committed
+const TasksCreatedChannel = "tasks_created"
+
+// Listener holds a dedicated connection subscribed to TasksCreatedChannel
+// and turns notifications into wake-ups for idle workers.
+// It is meant to run as a run.Group actor.
+type Listener struct {
+	pool              *pgxpool.Pool
+	log               logger.Logger
+	reconnectInterval time.Duration
+	wake              chan struct{}
+	connected         atomic.Bool
+	ctx               context.Context
+	cancel            context.CancelFunc
+}
+
+func NewListener(
+	pool              *pgxpool.Pool,
+	log               logger.Logger,
+	reconnectInterval time.Duration,
+) *Listener {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Listener{
+		pool:              pool,
+		log:               log.Named("pg-listener"),
+		reconnectInterval: reconnectInterval,
+		wake:              make(chan struct{}, 1),
+		ctx:               ctx,
+		cancel:            cancel,
+	}
+}
+
+// Wake returns a channel receiving a signal each time new tasks may be available
+func (l *Listener) Wake() <-chan struct{} {
+	return l.wake
+}
+
+// Connected reports whether notifications are currently being received
+func (l *Listener) Connected() bool {
+	return l.connected.Load()
+}
+
+// Run listens for notifications until Stop is called, reconnecting on failures
+func (l *Listener) Run() error {
+	for {
+		err := l.listen()
+		l.connected.Store(false)
+		if l.ctx.Err() != nil {
+			return nil
+		}
+		l.log.Warn("task listener disconnected, falling back to polling",
+			zap.Error(err),
+			zap.Duration("reconnect_in", l.reconnectInterval),
+		)
+
+		select {
+		case <-l.ctx.Done():
+			return nil
+		case <-time.After(l.reconnectInterval):
+		}
+	}
+}
+
+// Stop closes the listener connection
+func (l *Listener) Stop(error) {
+	l.cancel()
+}
+
+func (l *Listener) listen() error {
+	poolConn, err := l.pool.Acquire(l.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	// The connection keeps its LISTEN state, so it must never go back to the pool
+	conn := poolConn.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(l.ctx, "LISTEN "+TasksCreatedChannel); err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+
+	l.connected.Store(true)
+	l.log.Info("listening for task notifications", zap.String("channel", TasksCreatedChannel))
+	// Tasks may have been created while we were not listening
+	l.signal()
+
+	for {
+		if _, err := conn.WaitForNotification(l.ctx); err != nil {
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+		l.signal()
+	}
+}
+
+// signal wakes a worker without blocking, coalescing bursts of notifications
+func (l *Listener) signal() {
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
\ No newline at end of file
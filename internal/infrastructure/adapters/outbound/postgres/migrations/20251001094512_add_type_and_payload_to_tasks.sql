@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks
+    ADD COLUMN type VARCHAR(100) NOT NULL DEFAULT 'default',
+    ADD COLUMN payload JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE failed_tasks
+    ADD COLUMN type VARCHAR(100) NOT NULL DEFAULT 'default',
+    ADD COLUMN payload JSONB NOT NULL DEFAULT '{}'::jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE failed_tasks
+    DROP COLUMN IF EXISTS payload,
+    DROP COLUMN IF EXISTS type;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS payload,
+    DROP COLUMN IF EXISTS type;
+-- +goose StatementEnd
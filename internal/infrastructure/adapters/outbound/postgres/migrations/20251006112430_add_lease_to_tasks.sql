@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks
+    ADD COLUMN locked_by TEXT NULL,
+    ADD COLUMN locked_until TIMESTAMPTZ NULL;
+
+-- Tasks left in PROCESSING before leases existed are treated as expired
+UPDATE tasks SET locked_until = NOW() WHERE status = 'PROCESSING';
+
+CREATE INDEX idx_tasks_lease_expiry ON tasks (locked_until) WHERE status = 'PROCESSING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_lease_expiry;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS locked_until,
+    DROP COLUMN IF EXISTS locked_by;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks
+    ADD COLUMN next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+DROP INDEX IF EXISTS idx_tasks_ready;
+CREATE INDEX idx_tasks_ready ON tasks (status, next_attempt_at, created_at) WHERE attempts < max_attempts;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_ready;
+CREATE INDEX idx_tasks_ready ON tasks (status, created_at) WHERE attempts < max_attempts;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS next_attempt_at;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE INDEX idx_tasks_exhausted ON tasks (updated_at) WHERE status = 'FAILED' AND attempts >= max_attempts;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_exhausted;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tasks
+    ADD COLUMN priority INTEGER NOT NULL DEFAULT 0,
+    -- Position in the queue: creation time moved back by priority * aging interval
+    ADD COLUMN effective_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+UPDATE tasks SET effective_at = created_at;
+
+ALTER TABLE failed_tasks
+    ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
+
+DROP INDEX IF EXISTS idx_tasks_ready;
+CREATE INDEX idx_tasks_ready ON tasks (effective_at) WHERE status IN ('NEW', 'FAILED') AND attempts < max_attempts;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_ready;
+CREATE INDEX idx_tasks_ready ON tasks (status, next_attempt_at, created_at) WHERE attempts < max_attempts;
+
+ALTER TABLE failed_tasks
+    DROP COLUMN IF EXISTS priority;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS effective_at,
+    DROP COLUMN IF EXISTS priority;
+-- +goose StatementEnd
\ No newline at end of file
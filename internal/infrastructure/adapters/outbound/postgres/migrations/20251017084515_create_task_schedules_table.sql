@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE task_schedules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(100) NOT NULL UNIQUE,
+    cron_expr VARCHAR(100) NULL,
+    interval INTERVAL NULL,
+    task_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+    priority INTEGER NOT NULL DEFAULT 0,
+    misfire_policy VARCHAR(20) NOT NULL DEFAULT 'skip',
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    next_run_at TIMESTAMPTZ NOT NULL,
+    last_run_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- Exactly one of cron_expr and interval defines the schedule
+    CONSTRAINT task_schedules_spec_check CHECK ((cron_expr IS NULL) <> (interval IS NULL)),
+    CONSTRAINT task_schedules_misfire_check CHECK (misfire_policy IN ('skip', 'catch_up'))
+);
+
+CREATE INDEX idx_task_schedules_due ON task_schedules (next_run_at) WHERE enabled;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_task_schedules_due;
+DROP TABLE IF EXISTS task_schedules;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keyset pagination of task listings on (created_at, id)
+CREATE INDEX idx_tasks_created_at_id ON tasks (created_at DESC, id DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_created_at_id;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Queue statistics: per-status counts and the oldest NEW task without a table scan
+CREATE INDEX idx_tasks_status_created_at ON tasks (status, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_status_created_at;
+-- +goose StatementEnd
\ No newline at end of file
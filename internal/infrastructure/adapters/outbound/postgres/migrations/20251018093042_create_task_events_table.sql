@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Audit log of task state transitions. Rows outlive the task itself
+-- (dead-lettered and purged tasks), so there is no foreign key.
+CREATE TABLE task_events (
+    id BIGSERIAL PRIMARY KEY,
+    task_id UUID NOT NULL,
+    type TEXT NOT NULL,
+    attempt INTEGER NOT NULL DEFAULT 0,
+    message TEXT NULL,
+    owner TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_events_task_id ON task_events (task_id, id);
+CREATE INDEX idx_task_events_created_at ON task_events (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_task_events_created_at;
+DROP INDEX IF EXISTS idx_task_events_task_id;
+DROP TABLE IF EXISTS task_events;
+-- +goose StatementEnd
\ No newline at end of file
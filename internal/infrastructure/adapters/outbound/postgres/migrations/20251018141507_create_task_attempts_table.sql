@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One row per processing attempt. Like task_events, rows outlive the task.
+CREATE TABLE task_attempts (
+    id BIGSERIAL PRIMARY KEY,
+    task_id UUID NOT NULL,
+    attempt INTEGER NOT NULL,
+    owner TEXT NOT NULL,
+    outcome TEXT NOT NULL,
+    error_message TEXT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_task_attempts_task_id ON task_attempts (task_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_task_attempts_task_id;
+DROP TABLE IF EXISTS task_attempts;
+-- +goose StatementEnd
\ No newline at end of file
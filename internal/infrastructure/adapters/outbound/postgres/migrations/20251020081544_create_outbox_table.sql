@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Task lifecycle messages written with the state change they describe and
+-- removed once the relay has published them.
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    task_id UUID NOT NULL,
+    event TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS outbox;
+-- +goose StatementEnd
\ No newline at end of file
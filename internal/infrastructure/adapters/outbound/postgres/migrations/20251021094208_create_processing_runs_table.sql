@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Background processing runs, polled by clients from any instance
+CREATE TABLE processing_runs (
+    id UUID PRIMARY KEY,
+    status TEXT NOT NULL,
+    owner TEXT NOT NULL,
+    task_limit INTEGER NOT NULL,
+    min_delay_ms INTEGER NOT NULL DEFAULT 0,
+    max_delay_ms INTEGER NOT NULL DEFAULT 0,
+    acquired_count INTEGER NOT NULL DEFAULT 0,
+    processed_count INTEGER NOT NULL DEFAULT 0,
+    success_count INTEGER NOT NULL DEFAULT 0,
+    failed_count INTEGER NOT NULL DEFAULT 0,
+    error_count INTEGER NOT NULL DEFAULT 0,
+    released_count INTEGER NOT NULL DEFAULT 0,
+    error_message TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS processing_runs;
+-- +goose StatementEnd
\ No newline at end of file
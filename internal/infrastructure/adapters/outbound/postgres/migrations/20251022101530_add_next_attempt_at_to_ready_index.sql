@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Acquisition walks the queue by effective_at, next_attempt_at in the index lets
+-- it skip FAILED tasks still waiting out their backoff without reading their rows
+DROP INDEX IF EXISTS idx_tasks_ready;
+CREATE INDEX idx_tasks_ready ON tasks (effective_at, next_attempt_at) WHERE status IN ('NEW', 'FAILED') AND attempts < max_attempts;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_tasks_ready;
+CREATE INDEX idx_tasks_ready ON tasks (effective_at) WHERE status IN ('NEW', 'FAILED') AND attempts < max_attempts;
+-- +goose StatementEnd
\ No newline at end of file
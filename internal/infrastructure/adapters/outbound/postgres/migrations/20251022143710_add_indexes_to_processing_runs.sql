@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The orphaned run sweep looks for RUNNING runs by their last progress,
+-- retention deletes finished runs by their finish time
+CREATE INDEX idx_processing_runs_status_updated_at ON processing_runs (status, updated_at);
+CREATE INDEX idx_processing_runs_finished_at ON processing_runs (finished_at) WHERE finished_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_processing_runs_finished_at;
+DROP INDEX IF EXISTS idx_processing_runs_status_updated_at;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Messages are claimed for a lease and marked published afterwards, so no
+-- transaction stays open while the relay talks to the sink.
+ALTER TABLE outbox ADD COLUMN claimed_until TIMESTAMPTZ NULL;
+ALTER TABLE outbox ADD COLUMN published_at TIMESTAMPTZ NULL;
+
+-- Published messages are kept for the retention period; claims only read pending ones
+CREATE INDEX idx_outbox_pending ON outbox (id) WHERE published_at IS NULL;
+CREATE INDEX idx_outbox_published_at ON outbox (published_at) WHERE published_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_outbox_published_at;
+DROP INDEX IF EXISTS idx_outbox_pending;
+DELETE FROM outbox WHERE published_at IS NOT NULL;
+ALTER TABLE outbox DROP COLUMN IF EXISTS published_at;
+ALTER TABLE outbox DROP COLUMN IF EXISTS claimed_until;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// OutboxRepo implements persistence.OutboxRepository
+type OutboxRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewOutboxRepo creates new repository instance
+func NewOutboxRepo(pool *pgxpool.Pool) outboxrepo.OutboxRepository {
+	return &OutboxRepo{pool: pool}
+}
+
+// Append inserts the messages in order, so their IDs follow the order given
+func (r *OutboxRepo) Append(ctx context.Context, messages []*domain.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	querier := txManager.GetQuerier(ctx, r.pool)
+	batch := &pgx.Batch{}
+
+	for _, message := range messages {
+		batch.Queue(`
+			INSERT INTO outbox (task_id, event, payload)
+			VALUES ($1, $2, $3)
+			RETURNING id, created_at
+		`, message.TaskID, message.Event, message.Payload)
+	}
+
+	results := querier.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for _, message := range messages {
+		if err := results.QueryRow().Scan(&message.ID, &message.CreatedAt); err != nil {
+			return fmt.Errorf("failed to insert outbox message: %w", err)
+		}
+	}
+	return nil
+}
+
+// Claim reserves up to limit unpublished messages for lease and returns them in
+// the order they were written. While an earlier claim is held nothing is claimed,
+// the next batch could otherwise overtake the one being published.
+func (r *OutboxRepo) Claim(ctx context.Context, limit int, lease time.Duration) ([]*domain.OutboxMessage, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `
+		WITH claimed AS (
+			UPDATE outbox
+			SET claimed_until = NOW() + $1::interval
+			WHERE id IN (
+				SELECT id FROM outbox
+				WHERE published_at IS NULL
+				ORDER BY id ASC
+				LIMIT $2
+			)
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox
+				WHERE published_at IS NULL
+				AND claimed_until > NOW()
+			)
+			RETURNING id, task_id, event, payload, created_at
+		)
+		SELECT id, task_id, event, payload, created_at
+		FROM claimed
+		ORDER BY id ASC
+	`, lease, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.OutboxMessage, 0, limit)
+	for rows.Next() {
+		var message domain.OutboxMessage
+		err := rows.Scan(
+			&message.ID,
+			&message.TaskID,
+			&message.Event,
+			&message.Payload,
+			&message.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		messages = append(messages, &message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate outbox: %w", err)
+	}
+
+	return messages, nil
+}
+
+// MarkPublished records that the messages were published and drops their claim
+func (r *OutboxRepo) MarkPublished(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, `
+		UPDATE outbox
+		SET published_at = NOW(), claimed_until = NULL
+		WHERE id = ANY($1)
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox messages published: %w", err)
+	}
+	return nil
+}
+
+// Release drops the claim on messages that were not published
+func (r *OutboxRepo) Release(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, `
+		UPDATE outbox
+		SET claimed_until = NULL
+		WHERE id = ANY($1) AND published_at IS NULL
+	`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to release outbox messages: %w", err)
+	}
+	return nil
+}
+
+// DeletePublishedBefore removes up to limit messages published before cutoff, oldest first
+func (r *OutboxRepo) DeletePublishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		DELETE FROM outbox
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE published_at < $1
+			ORDER BY published_at ASC
+			LIMIT $2
+		)
+	`, cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete outbox messages: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
\ No newline at end of file
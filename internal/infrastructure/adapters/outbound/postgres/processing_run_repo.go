@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/processingrunrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ProcessingRunRepo implements persistence.ProcessingRunRepository
+type ProcessingRunRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewProcessingRunRepo creates new repository instance
+func NewProcessingRunRepo(pool *pgxpool.Pool) processingrunrepo.ProcessingRunRepository {
+	return &ProcessingRunRepo{pool: pool}
+}
+
+// Create inserts a new run into processing_runs
+func (r *ProcessingRunRepo) Create(ctx context.Context, run *domain.ProcessingRun) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	err := querier.QueryRow(ctx, `
+		INSERT INTO processing_runs (id, status, owner, task_limit, min_delay_ms, max_delay_ms)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING created_at, updated_at
+	`, run.ID, run.Status, run.Owner, run.Limit, run.MinDelayMS, run.MaxDelayMS).Scan(&run.CreatedAt, &run.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert processing run: %w", err)
+	}
+	return nil
+}
+
+// Get returns a run by ID
+func (r *ProcessingRunRepo) Get(ctx context.Context, runID uuid.UUID) (*domain.ProcessingRun, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	var run domain.ProcessingRun
+	var errorMessage *string
+	err := querier.QueryRow(ctx, `
+		SELECT id, status, owner, task_limit, min_delay_ms, max_delay_ms,
+		       acquired_count, processed_count, success_count, failed_count, error_count, released_count,
+		       error_message, created_at, updated_at, finished_at
+		FROM processing_runs
+		WHERE id = $1
+	`, runID).Scan(
+		&run.ID,
+		&run.Status,
+		&run.Owner,
+		&run.Limit,
+		&run.MinDelayMS,
+		&run.MaxDelayMS,
+		&run.Counters.Acquired,
+		&run.Counters.Processed,
+		&run.Counters.Succeeded,
+		&run.Counters.Failed,
+		&run.Counters.Errors,
+		&run.Counters.Released,
+		&errorMessage,
+		&run.CreatedAt,
+		&run.UpdatedAt,
+		&run.FinishedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrRunNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get processing run: %w", err)
+	}
+	if errorMessage != nil {
+		run.ErrorMessage = *errorMessage
+	}
+	return &run, nil
+}
+
+// AddProgress increments the counters in place, so concurrent updates do not overwrite each other
+func (r *ProcessingRunRepo) AddProgress(ctx context.Context, runID uuid.UUID, delta domain.RunCounters) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		UPDATE processing_runs
+		SET acquired_count = acquired_count + $2,
+		    processed_count = processed_count + $3,
+		    success_count = success_count + $4,
+		    failed_count = failed_count + $5,
+		    error_count = error_count + $6,
+		    released_count = released_count + $7,
+		    updated_at = NOW()
+		WHERE id = $1
+	`, runID, delta.Acquired, delta.Processed, delta.Succeeded, delta.Failed, delta.Errors, delta.Released)
+	if err != nil {
+		return fmt.Errorf("failed to update processing run progress: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrRunNotFound
+	}
+	return nil
+}
+
+// Finish sets the final status and finish time of a running run. A run
+// already finished, e.g. failed as orphaned, keeps its status.
+func (r *ProcessingRunRepo) Finish(ctx context.Context, runID uuid.UUID, status domain.RunStatus, errorMessage string) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	var message *string
+	if errorMessage != "" {
+		message = &errorMessage
+	}
+
+	tag, err := querier.Exec(ctx, `
+		UPDATE processing_runs
+		SET status = $2, error_message = $3, finished_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		AND status = $4
+	`, runID, status, message, domain.RunRunning)
+	if err != nil {
+		return fmt.Errorf("failed to finish processing run: %w", err)
+	}
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	var exists bool
+	err = querier.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM processing_runs WHERE id = $1)`, runID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check processing run: %w", err)
+	}
+	if !exists {
+		return domain.ErrRunNotFound
+	}
+	return domain.ErrRunFinished
+}
+
+// FailStale fails running runs whose progress was not refreshed for staleAfter.
+// Their instance stopped before finishing them, so nothing else would.
+func (r *ProcessingRunRepo) FailStale(ctx context.Context, staleAfter time.Duration, limit int) (int, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		UPDATE processing_runs
+		SET status = $1,
+		    error_message = 'run abandoned by ' || owner,
+		    finished_at = NOW(),
+		    updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM processing_runs
+			WHERE status = $2
+			AND updated_at < NOW() - $3 * INTERVAL '1 millisecond'
+			ORDER BY updated_at ASC
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+	`, domain.RunFailed, domain.RunRunning, staleAfter.Milliseconds(), limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail stale processing runs: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// DeleteFinishedBefore removes up to limit runs finished before cutoff, oldest first
+func (r *ProcessingRunRepo) DeleteFinishedBefore(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		DELETE FROM processing_runs
+		WHERE id IN (
+			SELECT id FROM processing_runs
+			WHERE finished_at < $1
+			ORDER BY finished_at ASC
+			LIMIT $2
+		)
+	`, cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete processing runs: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
\ No newline at end of file
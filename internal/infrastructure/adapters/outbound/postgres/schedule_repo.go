@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// uniqueViolation is the SQLSTATE raised on unique constraint conflicts
+const uniqueViolation = "23505"
+
+// scheduleColumns lists the task_schedules columns in the order expected by scanSchedule
+const scheduleColumns = `
+	id, name, cron_expr, interval, task_type, payload, priority,
+	misfire_policy, enabled, next_run_at, last_run_at, created_at, updated_at`
+
+// ScheduleRepo implements persistence.ScheduleRepository
+type ScheduleRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewScheduleRepo creates new repository instance
+func NewScheduleRepo(pool *pgxpool.Pool) schedulerepo.ScheduleRepository {
+	return &ScheduleRepo{pool: pool}
+}
+
+// Create stores a new schedule and fills its ID and timestamps
+func (r *ScheduleRepo) Create(ctx context.Context, schedule *domain.Schedule) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	err := querier.QueryRow(ctx, `
+		INSERT INTO task_schedules (
+			name, cron_expr, interval, task_type, payload, priority,
+			misfire_policy, enabled, next_run_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id, created_at, updated_at
+	`,
+		schedule.Name,
+		nullableString(schedule.CronExpr),
+		nullableDuration(schedule.Interval),
+		schedule.TaskType,
+		schedule.Payload,
+		schedule.Priority,
+		schedule.MisfirePolicy,
+		schedule.Enabled,
+		schedule.NextRunAt,
+	).Scan(&schedule.ID, &schedule.CreatedAt, &schedule.UpdatedAt)
+	if isUniqueViolation(err) {
+		return domain.ErrScheduleNameTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to insert schedule: %w", err)
+	}
+	return nil
+}
+
+// Get returns a schedule by ID
+func (r *ScheduleRepo) Get(ctx context.Context, id uuid.UUID) (*domain.Schedule, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	row := querier.QueryRow(ctx, `SELECT `+scheduleColumns+` FROM task_schedules WHERE id = $1`, id)
+	schedule, err := scanSchedule(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrScheduleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// List returns all schedules ordered by name
+func (r *ScheduleRepo) List(ctx context.Context) ([]*domain.Schedule, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `SELECT `+scheduleColumns+` FROM task_schedules ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+	return scanSchedules(rows)
+}
+
+// Update replaces the definition of an existing schedule
+func (r *ScheduleRepo) Update(ctx context.Context, schedule *domain.Schedule) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	err := querier.QueryRow(ctx, `
+		UPDATE task_schedules
+		SET name = $1,
+		    cron_expr = $2,
+		    interval = $3,
+		    task_type = $4,
+		    payload = $5,
+		    priority = $6,
+		    misfire_policy = $7,
+		    enabled = $8,
+		    next_run_at = $9,
+		    updated_at = NOW()
+		WHERE id = $10
+		RETURNING updated_at
+	`,
+		schedule.Name,
+		nullableString(schedule.CronExpr),
+		nullableDuration(schedule.Interval),
+		schedule.TaskType,
+		schedule.Payload,
+		schedule.Priority,
+		schedule.MisfirePolicy,
+		schedule.Enabled,
+		schedule.NextRunAt,
+		schedule.ID,
+	).Scan(&schedule.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrScheduleNotFound
+	}
+	if isUniqueViolation(err) {
+		return domain.ErrScheduleNameTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update schedule: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a schedule
+func (r *ScheduleRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `DELETE FROM task_schedules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrScheduleNotFound
+	}
+	return nil
+}
+
+// ListDue returns up to limit enabled schedules due at now, oldest first
+func (r *ScheduleRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT `+scheduleColumns+`
+		FROM task_schedules
+		WHERE enabled
+		AND next_run_at <= $1
+		ORDER BY next_run_at ASC
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due schedules: %w", err)
+	}
+	return scanSchedules(rows)
+}
+
+// MarkFired records the last fired tick and the next one
+func (r *ScheduleRepo) MarkFired(ctx context.Context, id uuid.UUID, lastRunAt, nextRunAt time.Time) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		UPDATE task_schedules
+		SET last_run_at = $1,
+		    next_run_at = $2,
+		    updated_at = NOW()
+		WHERE id = $3
+	`, lastRunAt, nextRunAt, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark schedule as fired: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrScheduleNotFound
+	}
+	return nil
+}
+
+// scanSchedule reads a single row selected with scheduleColumns
+func scanSchedule(row pgx.Row) (*domain.Schedule, error) {
+	var schedule domain.Schedule
+	var cronExpr *string
+	var interval *time.Duration
+
+	err := row.Scan(
+		&schedule.ID,
+		&schedule.Name,
+		&cronExpr,
+		&interval,
+		&schedule.TaskType,
+		&schedule.Payload,
+		&schedule.Priority,
+		&schedule.MisfirePolicy,
+		&schedule.Enabled,
+		&schedule.NextRunAt,
+		&schedule.LastRunAt,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if cronExpr != nil {
+		schedule.CronExpr = *cronExpr
+	}
+	if interval != nil {
+		schedule.Interval = *interval
+	}
+	return &schedule, nil
+}
+
+// scanSchedules reads all rows selected with scheduleColumns
+func scanSchedules(rows pgx.Rows) ([]*domain.Schedule, error) {
+	defer rows.Close()
+
+	schedules := make([]*domain.Schedule, 0)
+	for rows.Next() {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule: %w", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through schedules: %w", err)
+	}
+
+	return schedules, nil
+}
+
+func nullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nullableDuration(d time.Duration) *time.Duration {
+	if d == 0 {
+		return nil
+	}
+	return &d
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
\ No newline at end of file
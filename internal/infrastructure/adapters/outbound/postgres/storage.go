@@ -5,8 +5,15 @@ import (
 	"database/sql"
 	"fmt"
 	"task-processor/internal/application/ports/outbound/persistence/failedtaskrepo"
+	"task-processor/internal/application/ports/outbound/persistence/locker"
+	"task-processor/internal/application/ports/outbound/persistence/schedulerepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
 	"task-processor/internal/application/ports/outbound/persistence/txmanager"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/application/ports/outbound/persistence/outboxrepo"
+	"task-processor/internal/application/ports/outbound/persistence/processingrunrepo"
 	"task-processor/internal/infrastructure/adapters/outbound/circuitbreaker"
 	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
 	"task-processor/internal/infrastructure/config"
@@ -24,6 +31,13 @@ type Storage struct {
 	TxManager      txmanager.TxManager
 	TaskRepo  	   taskrepo.TaskRepository
 	FailedTaskRepo failedtaskrepo.FailedTaskRepository
+	ScheduleRepo   schedulerepo.ScheduleRepository
+	TaskEventRepo  taskeventrepo.TaskEventRepository
+	TaskAttemptRepo taskattemptrepo.TaskAttemptRepository
+	WebhookRepo    webhookrepo.WebhookDeliveryRepository
+	OutboxRepo     outboxrepo.OutboxRepository
+	ProcessingRunRepo processingrunrepo.ProcessingRunRepository
+	Locker         locker.Locker
 }
 
 // NewStorage initializes PostgreSQL storage with optional Circuit Breaker protection
@@ -57,11 +71,54 @@ func NewStorage(
 		return nil, fmt.Errorf("failed to create failedTask repository: %w", err)
 	}
 
+	scheduleRepo, err := createScheduleRepository(pool, logger, cfg)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create schedule repository: %w", err)
+	}
+
+	taskEventRepo, err := createTaskEventRepository(pool, logger, cfg)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create task event repository: %w", err)
+	}
+
+	taskAttemptRepo, err := createTaskAttemptRepository(pool, logger, cfg)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create task attempt repository: %w", err)
+	}
+
+	webhookRepo, err := createWebhookRepository(pool, logger, cfg)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create webhook repository: %w", err)
+	}
+
+	outboxRepo, err := createOutboxRepository(pool, logger, cfg)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create outbox repository: %w", err)
+	}
+
+	processingRunRepo, err := createProcessingRunRepository(pool, logger, cfg)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to create processing run repository: %w", err)
+	}
+
 	return &Storage{
 		pool:     		pool,
 		TxManager: 	    txManager,
 		TaskRepo: 		taskRepo,
 		FailedTaskRepo: failedTaskRepo,
+		ScheduleRepo:   scheduleRepo,
+		TaskEventRepo:  taskEventRepo,
+		TaskAttemptRepo: taskAttemptRepo,
+		WebhookRepo:    webhookRepo,
+		OutboxRepo:     outboxRepo,
+		ProcessingRunRepo: processingRunRepo,
+		Locker:         NewAdvisoryLocker(pool),
 	}, nil
 }
 
@@ -120,6 +177,7 @@ func createConnectionPool(ctx context.Context, cfg *config.Config) (*pgxpool.Poo
 	pgxCfg.MinConns = cfg.PG.MinPoolSize
 	pgxCfg.MaxConnLifetime = cfg.PG.MaxConnLife
 	pgxCfg.MaxConnIdleTime = cfg.PG.MaxConnIdle
+	pgxCfg.ConnConfig.Tracer = queryTracer{}
 
 	pool, err := pgxpool.NewWithConfig(ctx, pgxCfg)
 	if err != nil {
@@ -131,7 +189,7 @@ func createConnectionPool(ctx context.Context, cfg *config.Config) (*pgxpool.Poo
 
 // createTaskRepository initializes task repository with optional Circuit Breaker wrapper
 func createTaskRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *config.Config) (taskrepo.TaskRepository, error) {
-	baseRepo := NewTaskRepo(pool)
+	baseRepo := NewTaskRepo(pool, cfg.Priority.AgingInterval)
 
 	if cfg.CircuitBreaker.Enabled && logger != nil {
 		return circuitbreaker.NewTaskRepoDecorator(baseRepo, cfg, logger, "postgres-task-repo"), nil
@@ -148,5 +206,71 @@ func createFailedTaskRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *
 		return circuitbreaker.NewFailedTaskRepoDecorator(baseRepo, cfg, logger, "postgres-failedTask-repo"), nil
 	}
 
+	return baseRepo, nil
+}
+
+// createScheduleRepository initializes schedule repository with optional Circuit Breaker wrapper
+func createScheduleRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *config.Config) (schedulerepo.ScheduleRepository, error) {
+	baseRepo := NewScheduleRepo(pool)
+
+	if cfg.CircuitBreaker.Enabled && logger != nil {
+		return circuitbreaker.NewScheduleRepoDecorator(baseRepo, cfg, logger, "postgres-schedule-repo"), nil
+	}
+
+	return baseRepo, nil
+}
+
+// createTaskEventRepository initializes task event repository with optional Circuit Breaker wrapper
+func createTaskEventRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *config.Config) (taskeventrepo.TaskEventRepository, error) {
+	baseRepo := NewTaskEventRepo(pool)
+
+	if cfg.CircuitBreaker.Enabled && logger != nil {
+		return circuitbreaker.NewTaskEventRepoDecorator(baseRepo, cfg, logger, "postgres-task-event-repo"), nil
+	}
+
+	return baseRepo, nil
+}
+
+// createTaskAttemptRepository initializes task attempt repository with optional Circuit Breaker wrapper
+func createTaskAttemptRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *config.Config) (taskattemptrepo.TaskAttemptRepository, error) {
+	baseRepo := NewTaskAttemptRepo(pool)
+
+	if cfg.CircuitBreaker.Enabled && logger != nil {
+		return circuitbreaker.NewTaskAttemptRepoDecorator(baseRepo, cfg, logger, "postgres-task-attempt-repo"), nil
+	}
+
+	return baseRepo, nil
+}
+
+// createWebhookRepository initializes webhook delivery repository with optional Circuit Breaker wrapper
+func createWebhookRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *config.Config) (webhookrepo.WebhookDeliveryRepository, error) {
+	baseRepo := NewWebhookRepo(pool)
+
+	if cfg.CircuitBreaker.Enabled && logger != nil {
+		return circuitbreaker.NewWebhookRepoDecorator(baseRepo, cfg, logger, "postgres-webhook-repo"), nil
+	}
+
+	return baseRepo, nil
+}
+
+// createOutboxRepository initializes outbox repository with optional Circuit Breaker wrapper
+func createOutboxRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *config.Config) (outboxrepo.OutboxRepository, error) {
+	baseRepo := NewOutboxRepo(pool)
+
+	if cfg.CircuitBreaker.Enabled && logger != nil {
+		return circuitbreaker.NewOutboxRepoDecorator(baseRepo, cfg, logger, "postgres-outbox-repo"), nil
+	}
+
+	return baseRepo, nil
+}
+
+// createProcessingRunRepository initializes processing run repository with optional Circuit Breaker wrapper
+func createProcessingRunRepository(pool *pgxpool.Pool, logger logger.Logger, cfg  *config.Config) (processingrunrepo.ProcessingRunRepository, error) {
+	baseRepo := NewProcessingRunRepo(pool)
+
+	if cfg.CircuitBreaker.Enabled && logger != nil {
+		return circuitbreaker.NewProcessingRunRepoDecorator(baseRepo, cfg, logger, "postgres-processing-run-repo"), nil
+	}
+
 	return baseRepo, nil
 }
\ No newline at end of file
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/taskattemptrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TaskAttemptRepo implements persistence.TaskAttemptRepository
+type TaskAttemptRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTaskAttemptRepo creates new repository instance
+func NewTaskAttemptRepo(pool *pgxpool.Pool) taskattemptrepo.TaskAttemptRepository {
+	return &TaskAttemptRepo{pool: pool}
+}
+
+// Create inserts a finished attempt into task_attempts
+func (r *TaskAttemptRepo) Create(ctx context.Context, attempt *domain.TaskAttempt) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	var errorMessage *string
+	if attempt.ErrorMessage != "" {
+		errorMessage = &attempt.ErrorMessage
+	}
+
+	err := querier.QueryRow(ctx, `
+		INSERT INTO task_attempts (task_id, attempt, owner, outcome, error_message, started_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`, attempt.TaskID, attempt.Number, attempt.Owner, attempt.Outcome, errorMessage, attempt.StartedAt, attempt.FinishedAt).Scan(&attempt.ID)
+	if err != nil {
+		return fmt.Errorf("failed to insert task attempt: %w", err)
+	}
+	return nil
+}
+
+// ListByTask returns the attempts of a task in the order they were recorded
+func (r *TaskAttemptRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskAttempt, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT id, task_id, attempt, owner, outcome, error_message, started_at, finished_at
+		FROM task_attempts
+		WHERE task_id = $1
+		ORDER BY id ASC
+	`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task attempts: %w", err)
+	}
+	defer rows.Close()
+
+	attempts := make([]*domain.TaskAttempt, 0)
+	for rows.Next() {
+		var attempt domain.TaskAttempt
+		var errorMessage *string
+		err := rows.Scan(
+			&attempt.ID,
+			&attempt.TaskID,
+			&attempt.Number,
+			&attempt.Owner,
+			&attempt.Outcome,
+			&errorMessage,
+			&attempt.StartedAt,
+			&attempt.FinishedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task attempt: %w", err)
+		}
+		if errorMessage != nil {
+			attempt.ErrorMessage = *errorMessage
+		}
+		attempts = append(attempts, &attempt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through task attempts: %w", err)
+	}
+
+	return attempts, nil
+}
\ No newline at end of file
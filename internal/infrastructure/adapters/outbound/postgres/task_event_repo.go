@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/taskeventrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// TaskEventRepo implements persistence.TaskEventRepository
+type TaskEventRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewTaskEventRepo creates new repository instance
+func NewTaskEventRepo(pool *pgxpool.Pool) taskeventrepo.TaskEventRepository {
+	return &TaskEventRepo{pool: pool}
+}
+
+// ListByTask returns the events of a task in the order they were written
+func (r *TaskEventRepo) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*domain.TaskEvent, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT id, task_id, type, attempt, message, owner, created_at
+		FROM task_events
+		WHERE task_id = $1
+		ORDER BY id ASC
+	`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]*domain.TaskEvent, 0)
+	for rows.Next() {
+		var event domain.TaskEvent
+		var message, owner *string
+		err := rows.Scan(
+			&event.ID,
+			&event.TaskID,
+			&event.Type,
+			&event.Attempt,
+			&message,
+			&owner,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task event: %w", err)
+		}
+		if message != nil {
+			event.Message = *message
+		}
+		if owner != nil {
+			event.Owner = *owner
+		}
+		events = append(events, &event)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through task events: %w", err)
+	}
+
+	return events, nil
+}
+
+// DeleteOlderThan removes up to limit events created before cutoff, oldest first
+func (r *TaskEventRepo) DeleteOlderThan(ctx context.Context, cutoff time.Time, limit int) (int, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		DELETE FROM task_events
+		WHERE id IN (
+			SELECT id FROM task_events
+			WHERE created_at < $1
+			ORDER BY created_at ASC
+			LIMIT $2
+		)
+	`, cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete task events: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
\ No newline at end of file
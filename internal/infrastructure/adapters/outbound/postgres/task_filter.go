@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"strconv"
+	"strings"
+	"task-processor/internal/domain"
+)
+
+// likeEscaper escapes the LIKE wildcards so user input is matched literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// taskFilterQuery translates a domain.TaskFilter into a WHERE clause with
+// positional arguments. It works on tasks and failed_tasks alike.
+type taskFilterQuery struct {
+	conditions []string
+	args       []any
+}
+
+func newTaskFilterQuery(filter domain.TaskFilter) *taskFilterQuery {
+	q := &taskFilterQuery{}
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, len(filter.Statuses))
+		for i, status := range filter.Statuses {
+			statuses[i] = string(status)
+		}
+		q.where("status = ANY(" + q.arg(statuses) + "::text[]::task_status[])")
+	}
+	if filter.Type != "" {
+		q.where("type = " + q.arg(filter.Type))
+	}
+	if filter.CreatedFrom != nil {
+		q.where("created_at >= " + q.arg(*filter.CreatedFrom))
+	}
+	if filter.CreatedTo != nil {
+		q.where("created_at < " + q.arg(*filter.CreatedTo))
+	}
+	if filter.ErrorContains != "" {
+		q.where("error_message ILIKE " + q.arg("%"+likeEscaper.Replace(filter.ErrorContains)+"%"))
+	}
+	if filter.After != nil {
+		q.where("(created_at, id) < (" + q.arg(filter.After.CreatedAt) + ", " + q.arg(filter.After.ID) + ")")
+	}
+
+	return q
+}
+
+// arg binds a value and returns its placeholder
+func (q *taskFilterQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func (q *taskFilterQuery) where(condition string) {
+	q.conditions = append(q.conditions, condition)
+}
+
+// clause returns the WHERE clause, or an empty string when nothing is filtered
+func (q *taskFilterQuery) clause() string {
+	if len(q.conditions) == 0 {
+		return ""
+	}
+	return ` WHERE ` + strings.Join(q.conditions, " AND ")
+}
\ No newline at end of file
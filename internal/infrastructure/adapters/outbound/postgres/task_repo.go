@@ -2,27 +2,43 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
 	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var ErrTaskNotFound = errors.New("task not found")
+// ErrTaskNotFound is kept for callers that match on the postgres package
+var ErrTaskNotFound = domain.ErrTaskNotFound
+
+// taskColumns lists the tasks columns in the order expected by scanTask
+const taskColumns = `
+	id, type, payload, result, status, priority, created_at, updated_at,
+	attempts, max_attempts, error_message, locked_by, locked_until,
+	next_attempt_at, run_at, callback_url`
 
 // TaskRepo implements persistence.TaskRepository
 type TaskRepo struct {
-	pool *pgxpool.Pool
+	pool          *pgxpool.Pool
+	// priorityAging is how long a task has to wait to outrank a task
+	// created later with one more priority point
+	priorityAging time.Duration
 }
 
 // NewTaskRepo creates new repository instance
-func NewTaskRepo(pool *pgxpool.Pool) taskrepo.TaskRepository {
-	return &TaskRepo{pool: pool}
+func NewTaskRepo(pool *pgxpool.Pool, priorityAging time.Duration) taskrepo.TaskRepository {
+	return &TaskRepo{
+		pool:          pool,
+		priorityAging: priorityAging,
+	}
 }
 
 // BatchCreate creates multiple tasks in a single operation
@@ -36,11 +52,19 @@ func (r *TaskRepo) BatchCreate(ctx context.Context, tasks []*domain.Task) ([]uui
 
 	for _, task := range tasks {
 		batch.Queue(`
-			INSERT INTO tasks (status)
-			VALUES ($1)
-			RETURNING id
-		`, task.Status)
+			WITH created AS (
+				INSERT INTO tasks (status, type, payload, priority, run_at, next_attempt_at, effective_at, callback_url)
+				VALUES ($1, $2, $3, $4, $6, COALESCE($6, NOW()), COALESCE($6, NOW()) - $4 * $5::interval, $8)
+				RETURNING id
+			), event AS (
+				INSERT INTO task_events (task_id, type)
+				SELECT id, $7 FROM created
+			)
+			SELECT id FROM created
+		`, task.Status, task.Type, task.Payload, task.Priority, r.priorityAging, task.RunAt, domain.EventCreated, nullableString(task.CallbackURL))
 	}
+	// Delivered on commit, so listeners never see uncommitted tasks
+	batch.Queue(`SELECT pg_notify($1, $2)`, TasksCreatedChannel, strconv.Itoa(len(tasks)))
 
 	results := querier.SendBatch(ctx, batch)
 	defer results.Close()
@@ -57,6 +81,10 @@ func (r *TaskRepo) BatchCreate(ctx context.Context, tasks []*domain.Task) ([]uui
 		ids = append(ids, id)
 	}
 
+	if _, err := results.Exec(); err != nil {
+		errs = append(errs, fmt.Errorf("notify: %w", err))
+	}
+
 	if len(ids) == 0 {
 		return nil, fmt.Errorf("failed to insert tasks: %w", errors.Join(errs...))
 	}
@@ -69,118 +97,475 @@ func (r *TaskRepo) BatchCreate(ctx context.Context, tasks []*domain.Task) ([]uui
 }
 
 // AcquireTasks acquires tasks for processing with pessimistic locking
-func (r *TaskRepo) AcquireTasks(ctx context.Context, limit int) ([]*domain.Task, error) {
+// and leases them to lease.Owner until NOW() + lease.Duration.
+// Tasks are served by effective_at, so higher priorities go first while
+// older tasks eventually overtake newer ones of higher priority.
+func (r *TaskRepo) AcquireTasks(ctx context.Context, limit int, lease domain.Lease) ([]*domain.Task, error) {
 	querier := txManager.GetQuerier(ctx, r.pool)
 
 	query := `
-		UPDATE tasks 
-		SET 
-			status = $1,
-			attempts = attempts + 1
-		WHERE id IN (
-			SELECT id FROM tasks 
-			WHERE status IN ($2, $3) 
-			AND attempts < max_attempts
-			ORDER BY created_at ASC
-			LIMIT $4
-			FOR UPDATE SKIP LOCKED
+		WITH acquired AS (
+			UPDATE tasks 
+			SET 
+				status = $1,
+				attempts = attempts + 1,
+				locked_by = $2,
+				locked_until = NOW() + $3::interval,
+				updated_at = NOW()
+			WHERE id IN (
+				SELECT id FROM tasks 
+				-- Literal statuses let the planner match the idx_tasks_ready predicate
+				WHERE status IN ('NEW', 'FAILED')
+				AND attempts < max_attempts
+				AND next_attempt_at <= NOW()
+				ORDER BY effective_at ASC
+				LIMIT $4
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + taskColumns + `
+		), event AS (
+			INSERT INTO task_events (task_id, type, attempt, owner)
+			SELECT id, $5, attempts, locked_by FROM acquired
 		)
-		RETURNING 
-			id, status, created_at, updated_at, 
-			attempts, max_attempts, error_message	
-		`
+		SELECT ` + taskColumns + ` FROM acquired`
 
 	rows, err := querier.Query(ctx, query, 
 		domain.StatusProcessing, 
-		domain.StatusNew, 
-		domain.StatusFailed, 
+		lease.Owner,
+		lease.Duration,
 		limit,
+		domain.EventAcquired,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to acquire tasks: %w", err)
 	}
-	defer rows.Close()
 
-	tasks := make([]*domain.Task, 0, limit)
+	return scanTasks(rows, limit)
+}
 
+// ExtendLease prolongs the lease held by lease.Owner on a PROCESSING task
+func (r *TaskRepo) ExtendLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		UPDATE tasks
+		SET locked_until = NOW() + $1::interval,
+		    updated_at = NOW()
+		WHERE id = $2
+		  AND status = $3
+		  AND locked_by = $4
+	`, lease.Duration, taskID, domain.StatusProcessing, lease.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to extend lease: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrLeaseLost
+	}
+	return nil
+}
+
+// ReleaseLease returns a task leased by the caller to NEW, giving back the
+// attempt it consumed since processing never ran to an outcome
+func (r *TaskRepo) ReleaseLease(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		WITH released AS (
+			UPDATE tasks
+			SET status = $1,
+			    attempts = GREATEST(attempts - 1, 0),
+			    locked_by = NULL,
+			    locked_until = NULL,
+			    updated_at = NOW()
+			WHERE id = $2
+			  AND status = $3
+			  AND locked_by = $4
+			RETURNING id, attempts
+		)
+		INSERT INTO task_events (task_id, type, attempt, owner)
+		SELECT id, $5, attempts, $4 FROM released
+	`, domain.StatusNew, taskID, domain.StatusProcessing, lease.Owner, domain.EventReleased)
+	if err != nil {
+		return fmt.Errorf("failed to release lease: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrLeaseLost
+	}
+	return nil
+}
+
+// ReleaseExpiredLeases moves PROCESSING tasks with an expired lease to FAILED
+// so they are picked up again once their retry delay has passed. The attempt
+// consumed by the lost run is kept.
+func (r *TaskRepo) ReleaseExpiredLeases(ctx context.Context, limit int, retryAfter func(attempts int) time.Duration) (int, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `
+		SELECT id, attempts FROM tasks
+		WHERE status = $1
+		AND locked_until < NOW()
+		ORDER BY locked_until ASC
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, domain.StatusProcessing, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find expired leases: %w", err)
+	}
+
+	var (
+		ids      []uuid.UUID
+		delaysMS []int64
+	)
 	for rows.Next() {
-		var task domain.Task
-		var errorMsg *string
-
-		err := rows.Scan(
-			&task.ID,
-			&task.Status,
-			&task.CreatedAt,
-			&task.UpdatedAt,
-			&task.Attempts,
-			&task.MaxAttempts,
-			&errorMsg,
+		var (
+			id       uuid.UUID
+			attempts int
 		)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan task: %w", err)
+		if err := rows.Scan(&id, &attempts); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan expired lease: %w", err)
 		}
-		if errorMsg != nil {
-			task.ErrorMessage = *errorMsg
-		} else {
-			task.ErrorMessage = ""
-		}
-		tasks = append(tasks, &task)
+		ids = append(ids, id)
+		delaysMS = append(delaysMS, retryAfter(attempts).Milliseconds())
 	}
-
+	rows.Close()
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("error iterating through tasks: %w", err)
+		return 0, fmt.Errorf("failed to find expired leases: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
 	}
 
-	return tasks, nil
+	// The lease is checked again, a task finished or extended meanwhile stays as it is
+	tag, err := querier.Exec(ctx, `
+		WITH released AS (
+			UPDATE tasks t
+			SET status = $1,
+			    error_message = format('lease held by %s expired', COALESCE(t.locked_by, 'unknown')),
+			    next_attempt_at = NOW() + d.delay_ms * INTERVAL '1 millisecond',
+			    locked_by = NULL,
+			    locked_until = NULL,
+			    updated_at = NOW()
+			FROM unnest($2::uuid[], $3::bigint[]) AS d(id, delay_ms)
+			WHERE t.id = d.id
+			  AND t.status = $4
+			  AND t.locked_until < NOW()
+			RETURNING t.id, t.attempts, t.error_message
+		)
+		INSERT INTO task_events (task_id, type, attempt, message)
+		SELECT id, $5, attempts, error_message FROM released
+	`, domain.StatusFailed, ids, delaysMS, domain.StatusProcessing, domain.EventLeaseExpired)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release expired leases: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
 }
 
-// MarkAsProcessed marks task as processed
-func (r *TaskRepo) MarkAsProcessed(ctx context.Context, taskID uuid.UUID) error {
+// MarkAsProcessed marks a task leased by the caller as processed and stores the handler result
+func (r *TaskRepo) MarkAsProcessed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, result json.RawMessage) error {
 	querier := txManager.GetQuerier(ctx, r.pool)
 	
 	tag, err := querier.Exec(ctx, `
-		UPDATE tasks
-		SET status = $1
-		WHERE id = $2
-	`, domain.StatusProcessed, taskID)
+		WITH processed AS (
+			UPDATE tasks
+			SET status = $1,
+			    result = $2,
+			    locked_by = NULL,
+			    locked_until = NULL,
+			    updated_at = NOW()
+			WHERE id = $3
+			  AND status = $5
+			  AND locked_by = $6
+			RETURNING id, attempts
+		)
+		INSERT INTO task_events (task_id, type, attempt)
+		SELECT id, $4, attempts FROM processed
+	`, domain.StatusProcessed, result, taskID, domain.EventProcessed, domain.StatusProcessing, lease.Owner)
 	if err != nil {
 		return err
 	}
 	if tag.RowsAffected() == 0 {
-		return ErrTaskNotFound
+		return domain.ErrLeaseLost
 	}
 	return nil
 }
 
-// MarkAsFailed marks task as failed and records error message
-func (r *TaskRepo) MarkAsFailed(ctx context.Context, taskID uuid.UUID, errorMsg string) error {
+// MarkAsFailed marks a task leased by the caller as failed, records error
+// message and postpones the next attempt by retryAfter
+func (r *TaskRepo) MarkAsFailed(ctx context.Context, taskID uuid.UUID, lease domain.Lease, errorMsg string, retryAfter time.Duration) error {
 	querier := txManager.GetQuerier(ctx, r.pool)
 
 	tag, err := querier.Exec(ctx, `
-		UPDATE tasks
-		SET status = $1,
-		    error_message = $2
-		WHERE id = $3
-	`, domain.StatusFailed, errorMsg, taskID)
+		WITH failed AS (
+			UPDATE tasks
+			SET status = $1,
+			    error_message = $2,
+			    next_attempt_at = NOW() + $3::interval,
+			    locked_by = NULL,
+			    locked_until = NULL,
+			    updated_at = NOW()
+			WHERE id = $4
+			  AND status = $6
+			  AND locked_by = $7
+			RETURNING id, attempts
+		)
+		INSERT INTO task_events (task_id, type, attempt, message)
+		SELECT id, $5, attempts, $2 FROM failed
+	`, domain.StatusFailed, errorMsg, retryAfter, taskID, domain.EventFailed, domain.StatusProcessing, lease.Owner)
 	if err != nil {
 		return err
 	}
 	if tag.RowsAffected() == 0 {
-		return ErrTaskNotFound
+		return domain.ErrLeaseLost
 	}
 	return nil
 }
 
-// Delete removes task from table
-func (r *TaskRepo) Delete(ctx context.Context, taskID uuid.UUID) error {
+// Delete removes a task leased by the caller from table
+func (r *TaskRepo) Delete(ctx context.Context, taskID uuid.UUID, lease domain.Lease) error {
 	querier := txManager.GetQuerier(ctx, r.pool)
 
-	tag, err := querier.Exec(ctx, `DELETE FROM tasks WHERE id = $1`, taskID)
+	tag, err := querier.Exec(ctx, `
+		DELETE FROM tasks
+		WHERE id = $1
+		  AND status = $2
+		  AND locked_by = $3
+	`, taskID, domain.StatusProcessing, lease.Owner)
 	if err != nil {
 		return fmt.Errorf("failed to delete task: %w", err)
 	}
 	if tag.RowsAffected() == 0 {
-		return ErrTaskNotFound
+		return domain.ErrLeaseLost
+	}
+	return nil
+}
+
+// Restore inserts dead-lettered tasks back as NEW tasks with attempts reset.
+// Original IDs, payloads and creation times are kept; they are due immediately.
+func (r *TaskRepo) Restore(ctx context.Context, tasks []*domain.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	querier := txManager.GetQuerier(ctx, r.pool)
+	batch := &pgx.Batch{}
+
+	for _, task := range tasks {
+		batch.Queue(`
+			WITH restored AS (
+				INSERT INTO tasks (id, status, type, payload, priority, created_at, next_attempt_at, effective_at, callback_url)
+				VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW() - $5 * $7::interval, $9)
+				RETURNING id
+			)
+			INSERT INTO task_events (task_id, type)
+			SELECT id, $8 FROM restored
+		`, task.ID, domain.StatusNew, task.Type, task.Payload, task.Priority, task.CreatedAt, r.priorityAging, domain.EventRequeued, nullableString(task.CallbackURL))
 	}
+	batch.Queue(`SELECT pg_notify($1, $2)`, TasksCreatedChannel, strconv.Itoa(len(tasks)))
+
+	results := querier.SendBatch(ctx, batch)
+	defer results.Close()
+
+	for _, task := range tasks {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to restore task %s: %w", task.ID, err)
+		}
+	}
+	if _, err := results.Exec(); err != nil {
+		return fmt.Errorf("notify: %w", err)
+	}
+
 	return nil
 }
+
+// Get returns a task by ID
+func (r *TaskRepo) Get(ctx context.Context, taskID uuid.UUID) (*domain.Task, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	row := querier.QueryRow(ctx, `SELECT `+taskColumns+` FROM tasks WHERE id = $1`, taskID)
+	task, err := scanTask(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return task, nil
+}
+
+// List returns up to filter.Limit tasks matching filter, newest first.
+// Pages are addressed by the (created_at, id) keyset so deep pages stay cheap.
+func (r *TaskRepo) List(ctx context.Context, filter domain.TaskFilter) ([]*domain.Task, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	where := newTaskFilterQuery(filter)
+	query := `SELECT ` + taskColumns + ` FROM tasks` + where.clause() +
+		` ORDER BY created_at DESC, id DESC LIMIT ` + where.arg(filter.Limit)
+
+	rows, err := querier.Query(ctx, query, where.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	return scanTasks(rows, filter.Limit)
+}
+
+// Reschedule moves a pending task to runAt, keeping its priority advantage
+func (r *TaskRepo) Reschedule(ctx context.Context, taskID uuid.UUID, runAt time.Time) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	tag, err := querier.Exec(ctx, `
+		WITH rescheduled AS (
+			UPDATE tasks
+			SET run_at = $1,
+			    next_attempt_at = $1,
+			    effective_at = $1 - priority * $2::interval,
+			    updated_at = NOW()
+			WHERE id = $3
+			  AND status IN ($4, $5)
+			  AND attempts < max_attempts
+			RETURNING id, attempts
+		)
+		INSERT INTO task_events (task_id, type, attempt)
+		SELECT id, $6, attempts FROM rescheduled
+	`, runAt, r.priorityAging, taskID, domain.StatusNew, domain.StatusFailed, domain.EventRescheduled)
+	if err != nil {
+		return fmt.Errorf("failed to reschedule task: %w", err)
+	}
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	// Nothing was updated: tell a missing task from one that is no longer pending
+	var exists bool
+	err = querier.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM tasks WHERE id = $1)`, taskID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check task existence: %w", err)
+	}
+	if !exists {
+		return ErrTaskNotFound
+	}
+	return domain.ErrTaskNotPending
+}
+
+// DeleteExhausted removes up to limit FAILED tasks that have no attempts left
+// and returns them so the caller can move them to the dead-letter queue
+func (r *TaskRepo) DeleteExhausted(ctx context.Context, limit int) ([]*domain.Task, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	query := `
+		DELETE FROM tasks
+		WHERE id IN (
+			SELECT id FROM tasks
+			WHERE status = $1
+			AND attempts >= max_attempts
+			ORDER BY updated_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + taskColumns
+
+	rows, err := querier.Query(ctx, query, domain.StatusFailed, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete exhausted tasks: %w", err)
+	}
+
+	return scanTasks(rows, limit)
+}
+
+// Stats counts tasks per status, exhausted tasks and finds the oldest NEW task.
+// Counts come from idx_tasks_status_created_at and idx_tasks_exhausted,
+// so the cost grows with the index size rather than the table width.
+func (r *TaskRepo) Stats(ctx context.Context) (*domain.QueueStats, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `SELECT status, COUNT(*) FROM tasks GROUP BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tasks: %w", err)
+	}
+	defer rows.Close()
+
+	stats := &domain.QueueStats{ByStatus: make(map[domain.TaskStatus]int)}
+	for rows.Next() {
+		var status domain.TaskStatus
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan task count: %w", err)
+		}
+		stats.ByStatus[status] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through task counts: %w", err)
+	}
+
+	err = querier.QueryRow(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM tasks WHERE status = $1 AND attempts >= max_attempts),
+			(SELECT MIN(created_at) FROM tasks WHERE status = $2)
+	`, domain.StatusFailed, domain.StatusNew).Scan(&stats.Exhausted, &stats.OldestNewAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queue backlog: %w", err)
+	}
+
+	return stats, nil
+}
+
+// scanTask reads a single row selected with taskColumns
+func scanTask(row pgx.Row) (*domain.Task, error) {
+	var task domain.Task
+	var errorMsg *string
+	var lockedBy *string
+	var callbackURL *string
+
+	err := row.Scan(
+		&task.ID,
+		&task.Type,
+		&task.Payload,
+		&task.Result,
+		&task.Status,
+		&task.Priority,
+		&task.CreatedAt,
+		&task.UpdatedAt,
+		&task.Attempts,
+		&task.MaxAttempts,
+		&errorMsg,
+		&lockedBy,
+		&task.LockedUntil,
+		&task.NextAttemptAt,
+		&task.RunAt,
+		&callbackURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if errorMsg != nil {
+		task.ErrorMessage = *errorMsg
+	}
+	if lockedBy != nil {
+		task.LockedBy = *lockedBy
+	}
+	if callbackURL != nil {
+		task.CallbackURL = *callbackURL
+	}
+	return &task, nil
+}
+
+// scanTasks reads all rows selected with taskColumns
+func scanTasks(rows pgx.Rows, capacity int) ([]*domain.Task, error) {
+	defer rows.Close()
+
+	tasks := make([]*domain.Task, 0, capacity)
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through tasks: %w", err)
+	}
+
+	return tasks, nil
+}
\ No newline at end of file
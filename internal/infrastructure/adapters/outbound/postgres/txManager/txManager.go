@@ -14,6 +14,7 @@ type ctxTxKey struct{}
 type Querier interface{
 	Exec(ctx context.Context, sql string, arguments ...any) (commandTag pgconn.CommandTag, err error)
 	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
 	SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults 
 }
 
@@ -32,7 +33,14 @@ func NewTxManager(pool *pgxpool.Pool) *TxManager {
 	return &TxManager{pool: pool}
 }
 
+// WithTransaction runs fn in a transaction committed when fn succeeds.
+// Called inside another transaction, fn joins it instead, so use cases
+// composing each other commit their writes together.
 func (tm *TxManager) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := GetTx(ctx); ok {
+		return fn(ctx)
+	}
+
 	tx, err := tm.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
 		return fmt.Errorf("begin transaction: %w", err)
@@ -50,6 +58,30 @@ func (tm *TxManager) WithTransaction(ctx context.Context, fn func(ctx context.Co
 	return tx.Commit(ctx)
 }
 
+// WithSavepoint runs fn in a savepoint of the current transaction. When fn
+// fails only its writes are rolled back and the transaction can go on.
+// Outside a transaction it behaves like WithTransaction.
+func (tm *TxManager) WithSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	tx, ok := GetTx(ctx)
+	if !ok {
+		return tm.WithTransaction(ctx, fn)
+	}
+
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin savepoint: %w", err)
+	}
+	defer func() {
+		_ = savepoint.Rollback(ctx)
+	}()
+
+	if err := fn(context.WithValue(ctx, ctxTxKey{}, savepoint)); err != nil {
+		return err
+	}
+
+	return savepoint.Commit(ctx)
+}
+
 func GetTx(ctx context.Context) (pgx.Tx, bool) {
 	tx, ok := ctx.Value(ctxTxKey{}).(pgx.Tx)
 	return tx, ok
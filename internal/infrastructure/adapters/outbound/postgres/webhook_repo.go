@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"task-processor/internal/application/ports/outbound/persistence/webhookrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres/txManager"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// webhookDeliveryColumns lists the webhook_deliveries columns in the order expected by ClaimDue
+const webhookDeliveryColumns = `
+	id, task_id, event, url, payload, status, attempts, last_status_code,
+	last_error, next_attempt_at, created_at, delivered_at`
+
+// WebhookRepo implements persistence.WebhookDeliveryRepository
+type WebhookRepo struct {
+	pool *pgxpool.Pool
+}
+
+// NewWebhookRepo creates new repository instance
+func NewWebhookRepo(pool *pgxpool.Pool) webhookrepo.WebhookDeliveryRepository {
+	return &WebhookRepo{pool: pool}
+}
+
+// Create inserts a pending delivery, due immediately
+func (r *WebhookRepo) Create(ctx context.Context, delivery *domain.WebhookDelivery) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	err := querier.QueryRow(ctx, `
+		INSERT INTO webhook_deliveries (task_id, event, url, payload, status)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, delivery.TaskID, delivery.Event, delivery.URL, delivery.Payload, domain.DeliveryPending).Scan(&delivery.ID)
+	if err != nil {
+		return fmt.Errorf("failed to insert webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue counts an attempt on up to limit due deliveries, oldest due first,
+// and pushes their next attempt past the lease. Rows claimed concurrently are skipped.
+func (r *WebhookRepo) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*domain.WebhookDelivery, error) {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	rows, err := querier.Query(ctx, `
+		UPDATE webhook_deliveries
+		SET attempts = attempts + 1,
+		    next_attempt_at = NOW() + $1::interval,
+		    updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			-- Literal status lets the planner match the idx_webhook_deliveries_due predicate
+			WHERE status = 'pending'
+			AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at ASC
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+webhookDeliveryColumns, lease, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*domain.WebhookDelivery, 0, limit)
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+		var statusCode *int
+		var lastError *string
+		err := rows.Scan(
+			&delivery.ID,
+			&delivery.TaskID,
+			&delivery.Event,
+			&delivery.URL,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&statusCode,
+			&lastError,
+			&delivery.NextAttemptAt,
+			&delivery.CreatedAt,
+			&delivery.DeliveredAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		if statusCode != nil {
+			delivery.LastStatusCode = *statusCode
+		}
+		if lastError != nil {
+			delivery.LastError = *lastError
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating through webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// MarkDelivered sets delivery status to delivered
+func (r *WebhookRepo) MarkDelivered(ctx context.Context, id int64, statusCode int) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $1,
+		    last_status_code = $2,
+		    last_error = NULL,
+		    delivered_at = NOW(),
+		    updated_at = NOW()
+		WHERE id = $3
+	`, domain.DeliveryDelivered, statusCode, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook delivered: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed records the failure and makes the delivery due after retryAfter
+func (r *WebhookRepo) MarkFailed(ctx context.Context, id int64, statusCode int, message string, retryAfter time.Duration) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET last_status_code = $1,
+		    last_error = $2,
+		    next_attempt_at = NOW() + $3::interval,
+		    updated_at = NOW()
+		WHERE id = $4
+	`, nullableStatusCode(statusCode), message, retryAfter, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook failed: %w", err)
+	}
+	return nil
+}
+
+// MarkAbandoned records the failure and stops retrying the delivery
+func (r *WebhookRepo) MarkAbandoned(ctx context.Context, id int64, statusCode int, message string) error {
+	querier := txManager.GetQuerier(ctx, r.pool)
+
+	_, err := querier.Exec(ctx, `
+		UPDATE webhook_deliveries
+		SET status = $1,
+		    last_status_code = $2,
+		    last_error = $3,
+		    updated_at = NOW()
+		WHERE id = $4
+	`, domain.DeliveryAbandoned, nullableStatusCode(statusCode), message, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark webhook abandoned: %w", err)
+	}
+	return nil
+}
+
+// nullableStatusCode stores "no response" as NULL
+func nullableStatusCode(statusCode int) *int {
+	if statusCode == 0 {
+		return nil
+	}
+	return &statusCode
+}
\ No newline at end of file
@@ -0,0 +1,35 @@
+package taskhandler
+
+import (
+	"sync"
+
+	"task-processor/internal/application/ports/outbound/taskhandler"
+)
+
+// Registry implements taskhandler.Registry with an in-memory map keyed by task type
+type Registry struct {
+	mu       sync.RWMutex
+	handlers map[string]taskhandler.TaskHandler
+}
+
+// NewRegistry creates an empty handler registry
+func NewRegistry() *Registry {
+	return &Registry{
+		handlers: make(map[string]taskhandler.TaskHandler),
+	}
+}
+
+// Register binds handler to taskType, replacing any previous registration
+func (r *Registry) Register(taskType string, handler taskhandler.TaskHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[taskType] = handler
+}
+
+// Get returns the handler registered for taskType
+func (r *Registry) Get(taskType string) (taskhandler.TaskHandler, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	handler, ok := r.handlers[taskType]
+	return handler, ok
+}
\ No newline at end of file
@@ -0,0 +1,55 @@
+package taskhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"task-processor/internal/application/ports/inbound/random"
+	"task-processor/internal/application/ports/outbound/taskhandler"
+	"task-processor/internal/domain"
+)
+
+// SimulatedTaskType is the task type served by SimulatedHandler
+const SimulatedTaskType = "simulated"
+
+// defaultSuccessRate is used when the payload does not specify success_rate
+const defaultSuccessRate = 1.0
+
+// simulatedPayload is the optional configuration read from the task payload
+type simulatedPayload struct {
+	// SuccessRate determines the probability of successful processing (0.0 - 1.0)
+	SuccessRate *float64 `json:"success_rate"`
+}
+
+// SimulatedHandler succeeds or fails at random according to the success rate
+// given in the task payload. It does not perform any real work.
+type SimulatedHandler struct {
+	randomProvider random.RandomProvider
+}
+
+// NewSimulatedHandler creates a handler backed by the given random provider
+func NewSimulatedHandler(randomProvider random.RandomProvider) taskhandler.TaskHandler {
+	return &SimulatedHandler{randomProvider: randomProvider}
+}
+
+// Handle decides the outcome by comparing a random number with the success rate
+func (h *SimulatedHandler) Handle(ctx context.Context, task *domain.Task) (json.RawMessage, error) {
+	successRate := defaultSuccessRate
+
+	if len(task.Payload) > 0 {
+		var payload simulatedPayload
+		if err := json.Unmarshal(task.Payload, &payload); err != nil {
+			return nil, fmt.Errorf("invalid simulated payload: %w", err)
+		}
+		if payload.SuccessRate != nil {
+			successRate = *payload.SuccessRate
+		}
+	}
+
+	if h.randomProvider.Float64() > successRate {
+		return nil, fmt.Errorf("processing failed according to success rate %.2f", successRate)
+	}
+
+	return nil, nil
+}
\ No newline at end of file
@@ -0,0 +1,71 @@
+package taskhandler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"task-processor/internal/application/ports/inbound/random"
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSimulatedHandler_SuccessWithinRate(t *testing.T) {
+	mockRand := new(random.MockRandom)
+	mockRand.On("Float64").Return(0.5)
+
+	handler := NewSimulatedHandler(mockRand)
+	task := &domain.Task{Type: SimulatedTaskType, Payload: json.RawMessage(`{"success_rate":0.8}`)}
+
+	_, err := handler.Handle(context.Background(), task)
+
+	assert.NoError(t, err)
+}
+
+func TestSimulatedHandler_FailureAboveRate(t *testing.T) {
+	mockRand := new(random.MockRandom)
+	mockRand.On("Float64").Return(0.9)
+
+	handler := NewSimulatedHandler(mockRand)
+	task := &domain.Task{Type: SimulatedTaskType, Payload: json.RawMessage(`{"success_rate":0.8}`)}
+
+	_, err := handler.Handle(context.Background(), task)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "success rate 0.80")
+}
+
+func TestSimulatedHandler_DefaultRateSucceeds(t *testing.T) {
+	mockRand := new(random.MockRandom)
+	mockRand.On("Float64").Return(1.0)
+
+	handler := NewSimulatedHandler(mockRand)
+	task := &domain.Task{Type: SimulatedTaskType, Payload: json.RawMessage(`{}`)}
+
+	_, err := handler.Handle(context.Background(), task)
+
+	assert.NoError(t, err)
+}
+
+func TestSimulatedHandler_InvalidPayload(t *testing.T) {
+	handler := NewSimulatedHandler(new(random.MockRandom))
+	task := &domain.Task{Type: SimulatedTaskType, Payload: json.RawMessage(`[1,2]`)}
+
+	_, err := handler.Handle(context.Background(), task)
+
+	assert.Error(t, err)
+}
+
+func TestRegistry_RegisterAndGet(t *testing.T) {
+	registry := NewRegistry()
+	handler := NewSimulatedHandler(new(random.MockRandom))
+	registry.Register(SimulatedTaskType, handler)
+
+	got, ok := registry.Get(SimulatedTaskType)
+	assert.True(t, ok)
+	assert.Equal(t, handler, got)
+
+	_, ok = registry.Get("missing")
+	assert.False(t, ok)
+}
\ No newline at end of file
@@ -0,0 +1,143 @@
+package taskstream
+
+import (
+	"sync"
+	"task-processor/internal/domain"
+)
+
+// Hub fans task lifecycle events out to live subscribers and keeps the latest
+// ones in a bounded ring buffer, so a reconnecting client can resume after the
+// last event it received
+type Hub struct {
+	mu               sync.Mutex
+	// buffer holds the latest events, oldest at head
+	buffer           []*domain.StreamEvent
+	head             int
+	size             int
+	lastID           uint64
+	subscribers      map[*Subscription]struct{}
+	subscriberBuffer int
+	closed           bool
+}
+
+// NewHub keeps up to bufferSize events for resuming and lets each subscriber
+// fall up to subscriberBuffer events behind
+func NewHub(bufferSize, subscriberBuffer int) *Hub {
+	return &Hub{
+		buffer:           make([]*domain.StreamEvent, max(bufferSize, 1)),
+		subscribers:      make(map[*Subscription]struct{}),
+		subscriberBuffer: max(subscriberBuffer, 1),
+	}
+}
+
+// Subscription delivers events to a single subscriber
+type Subscription struct {
+	hub    *Hub
+	events chan *domain.StreamEvent
+}
+
+// Events is closed when the subscription ends: on Close, when the hub closes,
+// or when the subscriber fell too far behind and has to resume
+func (s *Subscription) Events() <-chan *domain.StreamEvent {
+	return s.events
+}
+
+// Close ends the subscription
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+// Publish assigns the next ID to the event, buffers it and hands it to every
+// subscriber. It never blocks: a subscriber with a full queue is disconnected
+// and expected to resume from the buffer.
+func (h *Hub) Publish(event *domain.StreamEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+
+	h.lastID++
+	published := *event
+	published.ID = h.lastID
+
+	h.buffer[(h.head+h.size)%len(h.buffer)] = &published
+	if h.size < len(h.buffer) {
+		h.size++
+	} else {
+		h.head = (h.head + 1) % len(h.buffer)
+	}
+
+	for sub := range h.subscribers {
+		select {
+		case sub.events <- &published:
+		default:
+			h.remove(sub)
+		}
+	}
+}
+
+// Subscribe receives events published from now on
+func (h *Hub) Subscribe() *Subscription {
+	sub, _ := h.subscribe(nil)
+	return sub
+}
+
+// SubscribeAfter also returns the buffered events published after lastEventID,
+// to be sent before those of the subscription. An ID newer than any published
+// one comes from before a restart, so the whole buffer is replayed.
+func (h *Hub) SubscribeAfter(lastEventID uint64) (*Subscription, []*domain.StreamEvent) {
+	return h.subscribe(&lastEventID)
+}
+
+// Close ends every subscription and drops later events
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.closed = true
+	for sub := range h.subscribers {
+		h.remove(sub)
+	}
+}
+
+func (h *Hub) subscribe(lastEventID *uint64) (*Subscription, []*domain.StreamEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sub := &Subscription{hub: h, events: make(chan *domain.StreamEvent, h.subscriberBuffer)}
+	if h.closed {
+		close(sub.events)
+		return sub, nil
+	}
+	h.subscribers[sub] = struct{}{}
+
+	if lastEventID == nil {
+		return sub, nil
+	}
+	after := *lastEventID
+	if after > h.lastID {
+		after = 0
+	}
+
+	var backlog []*domain.StreamEvent
+	for i := range h.size {
+		event := h.buffer[(h.head+i)%len(h.buffer)]
+		if event.ID > after {
+			backlog = append(backlog, event)
+		}
+	}
+	return sub, backlog
+}
+
+// remove unregisters the subscriber and closes its queue, h.mu must be held
+func (h *Hub) remove(sub *Subscription) {
+	if _, ok := h.subscribers[sub]; !ok {
+		return
+	}
+	delete(h.subscribers, sub)
+	close(sub.events)
+}
\ No newline at end of file
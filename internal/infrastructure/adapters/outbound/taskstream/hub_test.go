@@ -0,0 +1,106 @@
+package taskstream
+
+import (
+	"task-processor/internal/domain"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func publishN(hub *Hub, n int) {
+	for range n {
+		hub.Publish(&domain.StreamEvent{Type: domain.EventProcessed, TaskID: uuid.New()})
+	}
+}
+
+func ids(events []*domain.StreamEvent) []uint64 {
+	result := make([]uint64, len(events))
+	for i, event := range events {
+		result[i] = event.ID
+	}
+	return result
+}
+
+func TestHub_DeliversToSubscribers(t *testing.T) {
+	hub := NewHub(10, 10)
+	first := hub.Subscribe()
+	second := hub.Subscribe()
+
+	published := &domain.StreamEvent{Type: domain.EventAcquired, TaskID: uuid.New()}
+	hub.Publish(published)
+
+	for _, sub := range []*Subscription{first, second} {
+		event := <-sub.Events()
+		assert.Equal(t, uint64(1), event.ID)
+		assert.Equal(t, published.TaskID, event.TaskID)
+	}
+	// The caller's event is left untouched
+	assert.Zero(t, published.ID)
+}
+
+func TestHub_ResumesAfterLastEventID(t *testing.T) {
+	hub := NewHub(10, 10)
+	publishN(hub, 5)
+
+	_, backlog := hub.SubscribeAfter(3)
+	assert.Equal(t, []uint64{4, 5}, ids(backlog))
+
+	_, backlog = hub.SubscribeAfter(5)
+	assert.Empty(t, backlog)
+}
+
+func TestHub_BufferIsBounded(t *testing.T) {
+	hub := NewHub(3, 10)
+	publishN(hub, 5)
+
+	// Events 1 and 2 were evicted, the client resumes with what is left
+	_, backlog := hub.SubscribeAfter(1)
+	assert.Equal(t, []uint64{3, 4, 5}, ids(backlog))
+}
+
+func TestHub_ReplaysEverythingForUnknownID(t *testing.T) {
+	hub := NewHub(10, 10)
+	publishN(hub, 2)
+
+	_, backlog := hub.SubscribeAfter(100)
+	assert.Equal(t, []uint64{1, 2}, ids(backlog))
+}
+
+func TestHub_DisconnectsSlowSubscribers(t *testing.T) {
+	hub := NewHub(10, 2)
+	slow := hub.Subscribe()
+
+	publishN(hub, 3)
+
+	var received []*domain.StreamEvent
+	for event := range slow.Events() {
+		received = append(received, event)
+	}
+	assert.Equal(t, []uint64{1, 2}, ids(received))
+
+	// Resuming after the last received event recovers what was missed
+	_, backlog := hub.SubscribeAfter(2)
+	assert.Equal(t, []uint64{3}, ids(backlog))
+}
+
+func TestHub_CloseEndsSubscriptions(t *testing.T) {
+	hub := NewHub(10, 10)
+	sub := hub.Subscribe()
+	sub.Close()
+	// Closing twice is harmless
+	sub.Close()
+
+	_, open := <-sub.Events()
+	assert.False(t, open)
+
+	live := hub.Subscribe()
+	hub.Close()
+	_, open = <-live.Events()
+	assert.False(t, open)
+
+	late := hub.Subscribe()
+	_, open = <-late.Events()
+	require.False(t, open)
+}
\ No newline at end of file
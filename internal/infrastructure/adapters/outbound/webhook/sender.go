@@ -0,0 +1,148 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"task-processor/internal/application/ports/outbound/webhooksender"
+	"task-processor/internal/domain"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Headers sent with every delivery. Receivers verify SignatureHeader by
+// recomputing Sign over TimestampHeader and the raw body.
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	TimestampHeader = "X-Webhook-Timestamp"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+// signaturePrefix names the algorithm in SignatureHeader
+const signaturePrefix = "sha256="
+
+// maxDrainedBody bounds how much of a response is read to reuse the connection
+const maxDrainedBody = 64 << 10
+
+// ErrAddressNotAllowed is returned when a callback URL resolves to an internal address
+var ErrAddressNotAllowed = errors.New("webhook address not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range, internal like the private ranges
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// HTTPSender posts deliveries as JSON signed with HMAC-SHA256
+type HTTPSender struct {
+	client *http.Client
+	secret []byte
+	now    func() time.Time
+}
+
+// NewHTTPSender creates a sender; timeout bounds each delivery.
+// Callback URLs are user supplied, so redirects are not followed and only public
+// addresses are dialed, apart from the allowed networks.
+func NewHTTPSender(secret string, timeout time.Duration, allowed []netip.Prefix) webhooksender.Sender {
+	dialer := &net.Dialer{
+		Timeout:   timeout,
+		KeepAlive: 30 * time.Second,
+		Control:   dialControl(allowed),
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would dial on our behalf and bypass the address check
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &HTTPSender{
+		client: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		secret: []byte(secret),
+		now:    time.Now,
+	}
+}
+
+// Send posts the delivery payload and returns the response status code
+func (s *HTTPSender) Send(ctx context.Context, delivery *domain.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "task-processor-webhooks")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, signaturePrefix+Sign(s.secret, timestamp, delivery.Payload))
+	req.Header.Set(EventHeader, string(delivery.Event))
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(delivery.ID, 10))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainedBody))
+
+	return resp.StatusCode, nil
+}
+
+// dialControl rejects connections to internal addresses. It runs after name
+// resolution, so hostnames pointing at internal addresses are caught as well.
+func dialControl(allowed []netip.Prefix) func(network, address string, c syscall.RawConn) error {
+	return func(_, address string, _ syscall.RawConn) error {
+		host, _, err := net.SplitHostPort(address)
+		if err != nil {
+			return err
+		}
+		ip, err := netip.ParseAddr(host)
+		if err != nil {
+			return err
+		}
+		ip = ip.Unmap()
+
+		for _, prefix := range allowed {
+			if prefix.Contains(ip) {
+				return nil
+			}
+		}
+		if !isPublic(ip) {
+			return fmt.Errorf("%w: %s", ErrAddressNotAllowed, ip)
+		}
+		return nil
+	}
+}
+
+// isPublic reports whether ip is routable outside the local network
+func isPublic(ip netip.Addr) bool {
+	return ip.IsGlobalUnicast() &&
+		!ip.IsPrivate() &&
+		!sharedAddressSpace.Contains(ip)
+}
+
+// Sign returns the hex-encoded HMAC-SHA256 of "timestamp.body".
+// Including the timestamp lets receivers reject replayed deliveries.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
\ No newline at end of file
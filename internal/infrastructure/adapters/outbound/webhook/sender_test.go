@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"task-processor/internal/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loopback lets the tests reach their httptest receivers
+var loopback = []netip.Prefix{netip.MustParsePrefix("127.0.0.0/8"), netip.MustParsePrefix("::1/128")}
+
+func newDelivery(url string) *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:      42,
+		TaskID:  uuid.New(),
+		Event:   domain.WebhookTaskProcessed,
+		URL:     url,
+		Payload: []byte(`{"event":"task.processed"}`),
+	}
+}
+
+func TestHTTPSender_SignsPayload(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	sender := NewHTTPSender("s3cret", time.Second, loopback).(*HTTPSender)
+	sender.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	status, err := sender.Send(context.Background(), newDelivery(receiver.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, status)
+	require.NotNil(t, received)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "1700000000", received.Header.Get(TimestampHeader))
+	assert.Equal(t, "task.processed", received.Header.Get(EventHeader))
+	assert.Equal(t, "42", received.Header.Get(DeliveryHeader))
+	assert.JSONEq(t, `{"event":"task.processed"}`, string(body))
+
+	// Receivers recompute the signature over the timestamp and the raw body
+	expected := "sha256=" + Sign([]byte("s3cret"), "1700000000", body)
+	assert.Equal(t, expected, received.Header.Get(SignatureHeader))
+	assert.NotEqual(t, expected, "sha256="+Sign([]byte("other"), "1700000000", body))
+}
+
+func TestHTTPSender_ReturnsErrorStatus(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer receiver.Close()
+
+	status, err := NewHTTPSender("s3cret", time.Second, loopback).Send(context.Background(), newDelivery(receiver.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, status)
+}
+
+func TestHTTPSender_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer receiver.Close()
+	defer close(release)
+
+	status, err := NewHTTPSender("s3cret", 20*time.Millisecond, loopback).Send(context.Background(), newDelivery(receiver.URL))
+
+	assert.Error(t, err)
+	assert.Zero(t, status)
+}
+
+func TestHTTPSender_RefusesInternalAddress(t *testing.T) {
+	var called bool
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer receiver.Close()
+
+	status, err := NewHTTPSender("s3cret", time.Second, nil).Send(context.Background(), newDelivery(receiver.URL))
+
+	assert.ErrorIs(t, err, ErrAddressNotAllowed)
+	assert.Zero(t, status)
+	assert.False(t, called)
+}
+
+func TestHTTPSender_DoesNotFollowRedirects(t *testing.T) {
+	var followed bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		followed = true
+	}))
+	defer target.Close()
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer receiver.Close()
+
+	status, err := NewHTTPSender("s3cret", time.Second, loopback).Send(context.Background(), newDelivery(receiver.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTemporaryRedirect, status)
+	assert.False(t, followed)
+}
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1::", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"10.0.0.1", false},
+		{"172.16.5.4", false},
+		{"192.168.1.1", false},
+		{"fd00::1", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"224.0.0.1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.public, isPublic(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
+
+func TestDialControl_MapsIPv4InIPv6(t *testing.T) {
+	control := dialControl(nil)
+
+	assert.ErrorIs(t, control("tcp6", "[::ffff:127.0.0.1]:80", nil), ErrAddressNotAllowed)
+	assert.NoError(t, control("tcp4", "93.184.216.34:443", nil))
+}
+
+func TestSign_KnownVector(t *testing.T) {
+	// echo -n '1700000000.{}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t,
+		"9d713ed406bb7076d4123f0dc2c39d2df5c654ed4b0cd56b52c8b4c940bd63ae",
+		Sign([]byte("key"), "1700000000", []byte("{}")),
+	)
+}
\ No newline at end of file
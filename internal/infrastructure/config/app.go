@@ -1,7 +1,33 @@
 package config
 
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+)
+
 type App struct {
-	Name    string `envconfig:"APP_NAME"`
-	Version string `envconfig:"APP_VERSION"`
-	Env     string `envconfig:"APP_ENV"`
+	Name       string `envconfig:"APP_NAME"`
+	Version    string `envconfig:"APP_VERSION"`
+	Env        string `envconfig:"APP_ENV"`
+	InstanceID string `envconfig:"APP_INSTANCE_ID"`
+}
+
+// processNonce is generated once per process. Containers usually run the app as
+// PID 1, so the hostname and PID alone can repeat, e.g. after a restart in place.
+var processNonce = uuid.NewString()
+
+// Identity returns the instance ID, falling back to the hostname when it is not configured.
+// The fallback carries the process ID and a random per-process nonce, so no two
+// processes ever share leases.
+func (a App) Identity() string {
+	if a.InstanceID != "" {
+		return a.InstanceID
+	}
+	host := a.Name
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		host = hostname
+	}
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), processNonce)
 }
\ No newline at end of file
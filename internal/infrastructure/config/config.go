@@ -14,10 +14,24 @@ type Config struct {
 	PG             PG
 	Shutdown       Shutdown
 	WorkerPool     WorkerPool
+	Worker         Worker
 	RateLimit      RateLimit
 	Redis          Redis
 	HealthCheck    HealthCheck
 	CircuitBreaker CircuitBreaker
+	Lease          Lease
+	Retry          Retry
+	Sweeper        Sweeper
+	Priority       Priority
+	Scheduler      Scheduler
+	Stats          Stats
+	TaskEvents     TaskEvents
+	Webhook        Webhook
+	Outbox         Outbox
+	TaskStream     TaskStream
+	ProcessingRun  ProcessingRun
+	Metrics        Metrics
+	Tracing        Tracing
 }
 
 var (
@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+type Lease struct {
+	Duration        time.Duration `envconfig:"LEASE_DURATION"`
+	ReaperInterval  time.Duration `envconfig:"LEASE_REAPER_INTERVAL"`
+	ReaperBatchSize int           `envconfig:"LEASE_REAPER_BATCH_SIZE"`
+	// ReleaseTimeout bounds storing the outcome of a cancelled run or returning its task to the queue
+	ReleaseTimeout  time.Duration `envconfig:"LEASE_RELEASE_TIMEOUT"`
+}
\ No newline at end of file
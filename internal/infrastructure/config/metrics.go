@@ -0,0 +1,5 @@
+package config
+
+type Metrics struct {
+	Enabled bool `envconfig:"METRICS_ENABLED"`
+}
\ No newline at end of file
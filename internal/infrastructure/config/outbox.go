@@ -0,0 +1,20 @@
+package config
+
+import "time"
+
+type Outbox struct {
+	Enabled bool `envconfig:"OUTBOX_ENABLED"`
+	// Sink is "redis" or "stdout"
+	Sink string `envconfig:"OUTBOX_SINK"`
+	// Stream is the Redis stream events are appended to
+	Stream string `envconfig:"OUTBOX_STREAM"`
+	// StreamMaxLen approximately caps the stream length, zero leaves it unbounded
+	StreamMaxLen int64         `envconfig:"OUTBOX_STREAM_MAX_LEN"`
+	Interval     time.Duration `envconfig:"OUTBOX_INTERVAL"`
+	BatchSize    int           `envconfig:"OUTBOX_BATCH_SIZE"`
+	// ClaimLease is how long a claimed batch is reserved for the publishing instance
+	ClaimLease time.Duration `envconfig:"OUTBOX_CLAIM_LEASE"`
+	// Retention is how long published messages are kept before they are pruned
+	Retention     time.Duration `envconfig:"OUTBOX_RETENTION"`
+	PruneInterval time.Duration `envconfig:"OUTBOX_PRUNE_INTERVAL"`
+}
\ No newline at end of file
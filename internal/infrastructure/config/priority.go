@@ -0,0 +1,7 @@
+package config
+
+import "time"
+
+type Priority struct {
+	AgingInterval time.Duration `envconfig:"PRIORITY_AGING_INTERVAL"`
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+package config
+
+import "time"
+
+type ProcessingRun struct {
+	// StaleAfter is how long a running run may go without progress before it is failed as orphaned
+	StaleAfter     time.Duration `envconfig:"PROCESSING_RUN_STALE_AFTER"`
+	ReaperInterval time.Duration `envconfig:"PROCESSING_RUN_REAPER_INTERVAL"`
+	// Retention is how long finished runs are kept
+	Retention      time.Duration `envconfig:"PROCESSING_RUN_RETENTION"`
+	PruneInterval  time.Duration `envconfig:"PROCESSING_RUN_PRUNE_INTERVAL"`
+	BatchSize      int           `envconfig:"PROCESSING_RUN_BATCH_SIZE"`
+}
\ No newline at end of file
@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Retry struct {
+	BackoffBase       time.Duration `envconfig:"RETRY_BACKOFF_BASE"`
+	BackoffMultiplier float64       `envconfig:"RETRY_BACKOFF_MULTIPLIER"`
+	BackoffCap        time.Duration `envconfig:"RETRY_BACKOFF_CAP"`
+	BackoffJitter     string        `envconfig:"RETRY_BACKOFF_JITTER"`
+}
\ No newline at end of file
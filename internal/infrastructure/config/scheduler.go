@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+type Scheduler struct {
+	Enabled    bool          `envconfig:"SCHEDULER_ENABLED"`
+	Interval   time.Duration `envconfig:"SCHEDULER_INTERVAL"`
+	BatchSize  int           `envconfig:"SCHEDULER_BATCH_SIZE"`
+	MaxCatchUp int           `envconfig:"SCHEDULER_MAX_CATCH_UP"`
+}
\ No newline at end of file
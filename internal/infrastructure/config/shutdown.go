@@ -6,4 +6,7 @@ type Shutdown struct {
 	HTTPTimeout    time.Duration `envconfig:"SHUTDOWN_HTTP_TIMEOUT"`
 	HardPeriod     time.Duration `envconfig:"SHUTDOWN_HARD_PERIOD"`
 	ReadinessDrain time.Duration `envconfig:"SHUTDOWN_READINESS_DRAIN"`
-}
+	// TaskDrainTimeout is how long tasks in flight may run on after shutdown starts
+	// before they are interrupted and released back to the queue
+	TaskDrainTimeout time.Duration `envconfig:"SHUTDOWN_TASK_DRAIN_TIMEOUT"`
+}
\ No newline at end of file
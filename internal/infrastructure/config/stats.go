@@ -0,0 +1,7 @@
+package config
+
+import "time"
+
+type Stats struct {
+	CacheTTL time.Duration `envconfig:"STATS_CACHE_TTL"`
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+package config
+
+import "time"
+
+type Sweeper struct {
+	Interval  time.Duration `envconfig:"SWEEPER_INTERVAL"`
+	BatchSize int           `envconfig:"SWEEPER_BATCH_SIZE"`
+}
\ No newline at end of file
@@ -0,0 +1,9 @@
+package config
+
+import "time"
+
+type TaskEvents struct {
+	Retention     time.Duration `envconfig:"TASK_EVENTS_RETENTION"`
+	PruneInterval time.Duration `envconfig:"TASK_EVENTS_PRUNE_INTERVAL"`
+	PruneBatchSize int          `envconfig:"TASK_EVENTS_PRUNE_BATCH_SIZE"`
+}
\ No newline at end of file
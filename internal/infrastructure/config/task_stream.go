@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+type TaskStream struct {
+	// BufferSize is how many recent events are kept for clients resuming with Last-Event-ID
+	BufferSize        int           `envconfig:"TASK_STREAM_BUFFER_SIZE"`
+	// SubscriberBuffer is how far a client may fall behind before it is disconnected
+	SubscriberBuffer  int           `envconfig:"TASK_STREAM_SUBSCRIBER_BUFFER"`
+	KeepAliveInterval time.Duration `envconfig:"TASK_STREAM_KEEPALIVE_INTERVAL"`
+}
\ No newline at end of file
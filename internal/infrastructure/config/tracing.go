@@ -0,0 +1,10 @@
+package config
+
+type Tracing struct {
+	Enabled      bool    `envconfig:"TRACING_ENABLED"`
+	// Exporter is "stdout" or "otlp"
+	Exporter     string  `envconfig:"TRACING_EXPORTER"`
+	// OTLPEndpoint is the OTLP/HTTP collector URL, e.g. http://localhost:4318
+	OTLPEndpoint string  `envconfig:"TRACING_OTLP_ENDPOINT"`
+	SampleRatio  float64 `envconfig:"TRACING_SAMPLE_RATIO"`
+}
\ No newline at end of file
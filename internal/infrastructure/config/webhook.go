@@ -0,0 +1,68 @@
+package config
+
+import (
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+)
+
+type Webhook struct {
+	Enabled bool `envconfig:"WEBHOOK_ENABLED"`
+	// Secret signs every delivery with HMAC-SHA256
+	Secret string `envconfig:"WEBHOOK_SECRET"`
+	// TypeURLs are the default callback URLs by task type, e.g. "email=https://example.com/hooks"
+	TypeURLs TypeURLs `envconfig:"WEBHOOK_TYPE_URLS"`
+	// AllowedNetworks may be dialed despite being internal, e.g. "127.0.0.0/8" for local receivers
+	AllowedNetworks Networks      `envconfig:"WEBHOOK_ALLOWED_NETWORKS"`
+	Timeout         time.Duration `envconfig:"WEBHOOK_TIMEOUT"`
+	Interval        time.Duration `envconfig:"WEBHOOK_INTERVAL"`
+	BatchSize       int           `envconfig:"WEBHOOK_BATCH_SIZE"`
+	MaxAttempts     int           `envconfig:"WEBHOOK_MAX_ATTEMPTS"`
+	ClaimLease      time.Duration `envconfig:"WEBHOOK_CLAIM_LEASE"`
+	BackoffBase     time.Duration `envconfig:"WEBHOOK_BACKOFF_BASE"`
+	BackoffCap      time.Duration `envconfig:"WEBHOOK_BACKOFF_CAP"`
+}
+
+// TypeURLs maps task types to URLs. It is decoded from comma-separated
+// type=url pairs, since the default map syntax splits on the colon of the scheme.
+type TypeURLs map[string]string
+
+// Decode implements envconfig.Decoder
+func (t *TypeURLs) Decode(value string) error {
+	urls := make(TypeURLs)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		taskType, url, ok := strings.Cut(pair, "=")
+		if !ok || taskType == "" || url == "" {
+			return fmt.Errorf("invalid type URL %q, expected type=url", pair)
+		}
+		urls[taskType] = url
+	}
+	*t = urls
+	return nil
+}
+
+// Networks is a list of CIDR prefixes decoded from a comma-separated value
+type Networks []netip.Prefix
+
+// Decode implements envconfig.Decoder
+func (n *Networks) Decode(value string) error {
+	var networks Networks
+	for _, cidr := range strings.Split(value, ",") {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid network %q: %w", cidr, err)
+		}
+		networks = append(networks, prefix.Masked())
+	}
+	*n = networks
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+package config
+
+import "time"
+
+type Worker struct {
+	Enabled                 bool          `envconfig:"WORKER_ENABLED"`
+	BatchSize               int           `envconfig:"WORKER_BATCH_SIZE"`
+	PollInterval            time.Duration `envconfig:"WORKER_POLL_INTERVAL"`
+	MaxIdleBackoff          time.Duration `envconfig:"WORKER_MAX_IDLE_BACKOFF"`
+	ListenEnabled           bool          `envconfig:"WORKER_LISTEN_ENABLED"`
+	ListenReconnectInterval time.Duration `envconfig:"WORKER_LISTEN_RECONNECT_INTERVAL"`
+}
\ No newline at end of file
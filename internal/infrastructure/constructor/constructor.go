@@ -4,13 +4,20 @@ import (
 	"net/http"
 	"sync/atomic"
 	"task-processor/internal/application/ports/inbound/tasksprocessor"
+	"task-processor/internal/application/usecases/schedule"
 	"task-processor/internal/application/usecases/task"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/eventstream"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/failedtask"
 	"task-processor/internal/infrastructure/adapters/inbound/httpserver/health"
+	mtrcs "task-processor/internal/infrastructure/adapters/inbound/httpserver/metrics"
+	sched "task-processor/internal/infrastructure/adapters/inbound/httpserver/schedule"
 	"task-processor/internal/infrastructure/adapters/inbound/httpserver/swagger"
 	tsk "task-processor/internal/infrastructure/adapters/inbound/httpserver/task"
 	"task-processor/internal/infrastructure/adapters/outbound/postgres"
+	"task-processor/internal/infrastructure/adapters/outbound/taskstream"
 	"task-processor/internal/infrastructure/config"
 	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
 	mdlware "task-processor/internal/infrastructure/shared/middleware"
 	"task-processor/internal/infrastructure/shared/validator"
 
@@ -26,12 +33,15 @@ type InfraDeps struct {
 	PG     		   *postgres.Storage
 	Redis   	   *redis.Client 
 	Validator      *validator.Validator
+	Metrics        *metrics.Metrics
 }
 
 // AppDeps contains application-level services
 type AppDeps struct {
 	TaskUseCases   *task.UseCases
+	ScheduleUseCases *schedule.UseCases
 	TasksProcessor  tasksprocessor.TasksProcessor
+	TaskStream     *taskstream.Hub
 	IsShuttingDown *atomic.Bool
 }
 
@@ -44,20 +54,35 @@ type Dependencies struct {
 func Construct(router *chi.Mux, deps Dependencies) {
 	registerMiddleware(router, deps)
 	registerHealthController(router, deps)
+	registerMetricsController(router, deps)
 	registerTaskController(router, deps)
+	registerEventStreamController(router, deps)
+	registerFailedTaskController(router, deps)
+	registerScheduleController(router, deps)
 	registerSwaggerController(router)
 }
 
 func registerMiddleware(router *chi.Mux, deps Dependencies) {
 	middlewares := []func(http.Handler) http.Handler{
 		middleware.RequestID,
-		mdlware.LoggerMiddleware(deps.Infra.Logger),
+	}
+	// Before the logger so request logs carry the trace and span IDs
+	if deps.Infra.Config.Tracing.Enabled {
+		middlewares = append(middlewares, mdlware.TracingMiddleware)
+	}
+	middlewares = append(middlewares, mdlware.LoggerMiddleware(deps.Infra.Logger))
+	// Outside the rate limiter so rejected requests are counted too
+	if deps.Infra.Config.Metrics.Enabled {
+		middlewares = append(middlewares, mdlware.MetricsMiddleware(deps.Infra.Metrics))
+	}
+	middlewares = append(middlewares,
 		middleware.Recoverer,
 		mdlware.NewRedisRateLimiter(
 			deps.Infra.Redis,
 			deps.Infra.Config.RateLimit.RPS,
+			deps.Infra.Metrics,
 		).Middleware,
-	}
+	)
 
 	for _, mw := range middlewares {
 		router.Use(mw)
@@ -77,6 +102,14 @@ func registerHealthController(router *chi.Mux, deps Dependencies) {
 	healthController.RegisterRoutes(router)
 }
 
+func registerMetricsController(router *chi.Mux, deps Dependencies) {
+	if !deps.Infra.Config.Metrics.Enabled {
+		return
+	}
+	metricsController := mtrcs.NewController(deps.Infra.Metrics.Handler())
+	metricsController.RegisterRoutes(router)
+}
+
 func registerTaskController(router *chi.Mux, deps Dependencies) {
 	taskController := tsk.NewController(
 		deps.Infra.Validator, 
@@ -86,8 +119,32 @@ func registerTaskController(router *chi.Mux, deps Dependencies) {
 	taskController.RegisterRoutes(router)
 }
 
+func registerEventStreamController(router *chi.Mux, deps Dependencies) {
+	eventStreamController := eventstream.NewController(
+		deps.Infra.Validator,
+		deps.App.TaskStream,
+		deps.Infra.Config.TaskStream.KeepAliveInterval,
+	)
+	eventStreamController.RegisterRoutes(router)
+}
+
+func registerFailedTaskController(router *chi.Mux, deps Dependencies) {
+	failedTaskController := failedtask.NewController(
+		deps.Infra.Validator,
+		deps.App.TaskUseCases,
+	)
+	failedTaskController.RegisterRoutes(router)
+}
+
+func registerScheduleController(router *chi.Mux, deps Dependencies) {
+	scheduleController := sched.NewController(
+		deps.Infra.Validator,
+		deps.App.ScheduleUseCases,
+	)
+	scheduleController.RegisterRoutes(router)
+}
+
 func registerSwaggerController(router *chi.Mux) {
 	swaggerUI := swagger.NewController()
 	swaggerUI.RegisterRoutes(router)
-}
-
+}
\ No newline at end of file
@@ -0,0 +1,62 @@
+package lifecycle
+
+import (
+	"context"
+
+	"github.com/oklog/run"
+)
+
+// Group runs the application actors and fixes the order of the shutdown.
+// run.Group interrupts actors in the order they were added, so the
+// termination signal is handled first, tasks in flight are drained next and
+// only then are the actors added with Add (jobs, worker, HTTP server) stopped.
+type Group struct {
+	group run.Group
+}
+
+// NewGroup starts the shutdown once signalCtx is done. onSignal runs first,
+// drain runs after it and must return once tasks in flight are finished or released.
+func NewGroup(
+	signalCtx context.Context,
+	onSignal  func(),
+	drain     func(),
+) *Group {
+	g := &Group{}
+
+	// --- OS signal listener ---
+	g.group.Add(
+		func() error {
+			<-signalCtx.Done()
+			// Returning nil triggers the shutdown sequence in the other actors
+			return nil
+		},
+		func(error) {
+			onSignal()
+		},
+	)
+
+	// --- Task processing drain ---
+	drainDone := make(chan struct{})
+	g.group.Add(
+		func() error {
+			<-drainDone
+			return nil
+		},
+		func(error) {
+			drain()
+			close(drainDone)
+		},
+	)
+
+	return g
+}
+
+// Add registers an actor stopped after the drain, in the order actors are added
+func (g *Group) Add(execute func() error, interrupt func(error)) {
+	g.group.Add(execute, interrupt)
+}
+
+// Run runs all actors until the first one returns, then interrupts them all
+func (g *Group) Run() error {
+	return g.group.Run()
+}
\ No newline at end of file
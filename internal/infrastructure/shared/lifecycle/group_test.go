@@ -0,0 +1,57 @@
+package lifecycle
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroup_DrainsBeforeStoppingActors(t *testing.T) {
+	signalCtx, signal := context.WithCancel(context.Background())
+	defer signal()
+
+	var (
+		mu    sync.Mutex
+		steps []string
+	)
+	record := func(step string) {
+		mu.Lock()
+		defer mu.Unlock()
+		steps = append(steps, step)
+	}
+
+	g := NewGroup(signalCtx, func() { record("signal") }, func() {
+		// A slow drain must still finish before the actors are stopped
+		time.Sleep(20 * time.Millisecond)
+		record("drain")
+	})
+	for _, name := range []string{"worker", "http"} {
+		stop := make(chan struct{})
+		g.Add(
+			func() error {
+				<-stop
+				return nil
+			},
+			func(error) {
+				record(name)
+				close(stop)
+			},
+		)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- g.Run() }()
+	signal()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("group did not stop")
+	}
+	assert.Equal(t, []string{"signal", "drain", "worker", "http"}, steps)
+}
\ No newline at end of file
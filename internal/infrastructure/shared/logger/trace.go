@@ -0,0 +1,30 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// TraceFields returns the trace and span IDs of the span in ctx as log fields,
+// or nothing when ctx carries no valid span
+func TraceFields(ctx context.Context) []Field {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return nil
+	}
+	return []Field{
+		zap.String("trace_id", spanCtx.TraceID().String()),
+		zap.String("span_id", spanCtx.SpanID().String()),
+	}
+}
+
+// WithTrace returns log enriched with the trace and span IDs of the span in ctx
+func WithTrace(ctx context.Context, log Logger) Logger {
+	fields := TraceFields(ctx)
+	if len(fields) == 0 {
+		return log
+	}
+	return log.With(fields...)
+}
\ No newline at end of file
@@ -0,0 +1,184 @@
+package metrics
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/gammazero/workerpool"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sony/gobreaker"
+)
+
+const namespace = "task_processor"
+
+// Metrics holds the application collectors and the registry exposing them
+type Metrics struct {
+	registry *prometheus.Registry
+
+	// HTTP requests by method, chi route pattern and status code
+	HTTPRequests        *prometheus.CounterVec
+	HTTPRequestDuration *prometheus.HistogramVec
+
+	// Task lifecycle as seen by the concurrent processor
+	TasksAcquired     prometheus.Counter
+	TasksProcessed    *prometheus.CounterVec
+	TasksFailed       *prometheus.CounterVec
+	TasksErrored      *prometheus.CounterVec
+	TasksDeadLettered *prometheus.CounterVec
+	TasksReleased     prometheus.Counter
+	TaskDuration      *prometheus.HistogramVec
+
+	// Circuit breaker state by breaker and operation: 0 closed, 1 half-open, 2 open
+	CircuitBreakerState *prometheus.GaugeVec
+
+	// Requests rejected by the rate limiter and limiter failures let through
+	RateLimitRejections prometheus.Counter
+	RateLimitErrors     prometheus.Counter
+}
+
+var (
+	instance *Metrics
+	once     sync.Once
+)
+
+// GetMetrics returns a singleton instance of Metrics
+func GetMetrics() *Metrics {
+	once.Do(func() {
+		instance = New()
+	})
+	return instance
+}
+
+// New creates Metrics with its own registry, including Go runtime and process collectors
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		HTTPRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "HTTP requests by method, route and status code.",
+		}, []string{"method", "route", "status"}),
+		HTTPRequestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+
+		TasksAcquired: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "tasks",
+			Name:      "acquired_total",
+			Help:      "Tasks leased for processing.",
+		}),
+		TasksProcessed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "tasks",
+			Name:      "processed_total",
+			Help:      "Tasks processed successfully by type.",
+		}, []string{"type"}),
+		TasksFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "tasks",
+			Name:      "failed_total",
+			Help:      "Failed processing attempts by type.",
+		}, []string{"type"}),
+		TasksErrored: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "tasks",
+			Name:      "errored_total",
+			Help:      "Tasks whose outcome could not be stored by type; they stay leased until the lease expires.",
+		}, []string{"type"}),
+		TasksDeadLettered: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "tasks",
+			Name:      "dead_lettered_total",
+			Help:      "Tasks moved to the dead-letter queue by the component that moved them.",
+		}, []string{"source"}),
+		TasksReleased: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "tasks",
+			Name:      "released_total",
+			Help:      "Tasks returned to the queue after their processing was cancelled.",
+		}),
+		TaskDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "tasks",
+			Name:      "processing_duration_seconds",
+			Help:      "Time spent processing a task by type and outcome.",
+			Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+		}, []string{"type", "outcome"}),
+
+		CircuitBreakerState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "circuit_breaker",
+			Name:      "state",
+			Help:      "Circuit breaker state: 0 closed, 1 half-open, 2 open.",
+		}, []string{"breaker", "operation"}),
+
+		RateLimitRejections: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rate_limit",
+			Name:      "rejections_total",
+			Help:      "Requests rejected with 429 by the rate limiter.",
+		}),
+		RateLimitErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rate_limit",
+			Name:      "errors_total",
+			Help:      "Rate limiter failures; the request was let through.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.HTTPRequests,
+		m.HTTPRequestDuration,
+		m.TasksAcquired,
+		m.TasksProcessed,
+		m.TasksFailed,
+		m.TasksErrored,
+		m.TasksDeadLettered,
+		m.TasksReleased,
+		m.TaskDuration,
+		m.CircuitBreakerState,
+		m.RateLimitRejections,
+		m.RateLimitErrors,
+	)
+
+	return m
+}
+
+// Handler serves the registry in the Prometheus text exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// SetCircuitBreakerState records the current state of a breaker operation
+func (m *Metrics) SetCircuitBreakerState(breaker, operation string, state gobreaker.State) {
+	m.CircuitBreakerState.WithLabelValues(breaker, operation).Set(float64(state))
+}
+
+// RegisterWorkerPool exposes the number of tasks waiting for a free worker
+func (m *Metrics) RegisterWorkerPool(wp *workerpool.WorkerPool) error {
+	return m.registry.Register(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "worker_pool",
+		Name:      "queue_length",
+		Help:      "Tasks submitted to the worker pool and waiting for a free worker.",
+	}, func() float64 {
+		return float64(wp.WaitingQueueSize())
+	}))
+}
+
+// RegisterPgxPool exposes the connection pool statistics
+func (m *Metrics) RegisterPgxPool(pool *pgxpool.Pool) error {
+	return m.registry.Register(newPgxPoolCollector(pool))
+}
\ No newline at end of file
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// pgxPoolCollector reads pgxpool statistics on every scrape
+type pgxPoolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns      *prometheus.Desc
+	idleConns          *prometheus.Desc
+	totalConns         *prometheus.Desc
+	maxConns           *prometheus.Desc
+	acquires           *prometheus.Desc
+	emptyAcquires      *prometheus.Desc
+	canceledAcquires   *prometheus.Desc
+	acquireWaitSeconds *prometheus.Desc
+}
+
+func newPgxPoolCollector(pool *pgxpool.Pool) *pgxPoolCollector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "pgxpool", name), help, nil, nil)
+	}
+	return &pgxPoolCollector{
+		pool:               pool,
+		acquiredConns:      desc("acquired_conns", "Connections currently in use."),
+		idleConns:          desc("idle_conns", "Idle connections in the pool."),
+		totalConns:         desc("total_conns", "Connections open, including those being established."),
+		maxConns:           desc("max_conns", "Maximum size of the pool."),
+		acquires:           desc("acquires_total", "Successful connection acquisitions."),
+		emptyAcquires:      desc("empty_acquires_total", "Acquisitions that had to wait for a connection."),
+		canceledAcquires:   desc("canceled_acquires_total", "Acquisitions cancelled by their context."),
+		acquireWaitSeconds: desc("acquire_wait_seconds_total", "Total time spent waiting for a connection."),
+	}
+}
+
+func (c *pgxPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.acquires
+	ch <- c.emptyAcquires
+	ch <- c.canceledAcquires
+	ch <- c.acquireWaitSeconds
+}
+
+func (c *pgxPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquires, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquires, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquires, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireWaitSeconds, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+}
@@ -9,7 +9,7 @@ import (
 	"go.uber.org/zap"
 )
 
-func LoggerMiddleware(logger logger.Logger) func(next http.Handler) http.Handler {
+func LoggerMiddleware(log logger.Logger) func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			start := time.Now()
@@ -17,7 +17,7 @@ func LoggerMiddleware(logger logger.Logger) func(next http.Handler) http.Handler
 			
 			next.ServeHTTP(w, r)
 
-			logger.Info("request completed",
+			logger.WithTrace(r.Context(), log).Info("request completed",
 				zap.String("request_id", reqID),
 				zap.String("method", r.Method),
 				zap.String("url", r.URL.String()),
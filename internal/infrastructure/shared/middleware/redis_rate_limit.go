@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"time"
 
+	"task-processor/internal/infrastructure/shared/metrics"
+
 	"github.com/go-redis/redis_rate/v10"
 	"github.com/redis/go-redis/v9"
 )
@@ -20,12 +22,14 @@ const (
 type RedisRateLimiter struct {
 	limiter *redis_rate.Limiter
 	rps     int
+	metrics *metrics.Metrics
 }
 
-func NewRedisRateLimiter(redisClient *redis.Client, rps int) *RedisRateLimiter {
+func NewRedisRateLimiter(redisClient *redis.Client, rps int, metrics *metrics.Metrics) *RedisRateLimiter {
 	return &RedisRateLimiter{
 		limiter: redis_rate.NewLimiter(redisClient),
 		rps:     rps,
+		metrics: metrics,
 	}
 }
 
@@ -38,12 +42,15 @@ func (r *RedisRateLimiter) Middleware(next http.Handler) http.Handler {
 		res, err := r.limiter.Allow(req.Context(), key, redis_rate.PerSecond(r.rps))
 		if err != nil {
 			// On Redis error, let the request pass (fail open)
+			r.metrics.RateLimitErrors.Inc()
 			next.ServeHTTP(w, req)
 			return
 		}
 
 		// Rate limit exceeded
 		if res.Allowed == 0 {
+			r.metrics.RateLimitRejections.Inc()
+
 			// Prepare response body first
 			response := map[string]string{
 				"error": rateLimitExceeded,
@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"context"
+	"task-processor/internal/infrastructure/config"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetup_Disabled(t *testing.T) {
+	shutdown, err := Setup(context.Background(), &config.Config{})
+
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestSetup_Stdout(t *testing.T) {
+	cfg := &config.Config{
+		App:     config.App{Name: "task-processor", InstanceID: "test"},
+		Tracing: config.Tracing{Enabled: true, Exporter: ExporterStdout, SampleRatio: 1},
+	}
+
+	shutdown, err := Setup(context.Background(), cfg)
+
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestSetup_UnknownExporter(t *testing.T) {
+	cfg := &config.Config{
+		Tracing: config.Tracing{Enabled: true, Exporter: "jaeger"},
+	}
+
+	_, err := Setup(context.Background(), cfg)
+
+	assert.ErrorContains(t, err, `unknown trace exporter "jaeger"`)
+}
\ No newline at end of file
@@ -0,0 +1,115 @@
+package eventstream
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/eventstream"
+	"task-processor/internal/infrastructure/adapters/outbound/taskstream"
+	"task-processor/internal/infrastructure/shared/validator"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// message is one SSE message, or a comment when only comment is set
+type message struct {
+	id      string
+	event   string
+	data    string
+	comment string
+}
+
+// setupServer serves the event stream of a fresh hub
+func setupServer(t *testing.T, bufferSize int, keepAlive time.Duration) (*taskstream.Hub, *httptest.Server) {
+	hub := taskstream.NewHub(bufferSize, 16)
+	controller := eventstream.NewController(validator.New(), hub, keepAlive)
+
+	r := chi.NewRouter()
+	controller.RegisterRoutes(r)
+	srv := httptest.NewServer(r)
+
+	t.Cleanup(func() {
+		hub.Close()
+		srv.Close()
+	})
+	return hub, srv
+}
+
+// connect opens the stream and returns a channel of the messages it receives
+func connect(t *testing.T, srv *httptest.Server, query string, lastEventID string) <-chan message {
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/api/v1/tasks/events/stream"+query, nil)
+	require.NoError(t, err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := srv.Client().Do(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	messages := make(chan message, 64)
+	go func() {
+		defer close(messages)
+		var msg message
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				messages <- msg
+				msg = message{}
+			case strings.HasPrefix(line, ":"):
+				msg.comment = strings.TrimSpace(strings.TrimPrefix(line, ":"))
+			case strings.HasPrefix(line, "id: "):
+				msg.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				msg.event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				msg.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	return messages
+}
+
+// next waits for the next message that is not a ping
+func next(t *testing.T, messages <-chan message) message {
+	t.Helper()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg, ok := <-messages:
+			require.True(t, ok, "stream closed")
+			if msg.comment == "" {
+				return msg
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for an event")
+		}
+	}
+}
+
+// waitSubscribed gives the handler time to subscribe before events are published
+func waitSubscribed() {
+	time.Sleep(100 * time.Millisecond)
+}
+
+func newEvent(eventType domain.TaskEventType, taskType string, status domain.TaskStatus) *domain.StreamEvent {
+	return &domain.StreamEvent{
+		Type:       eventType,
+		TaskID:     uuid.New(),
+		TaskType:   taskType,
+		Status:     status,
+		Attempt:    1,
+		OccurredAt: time.Now().UTC(),
+	}
+}
\ No newline at end of file
@@ -0,0 +1,122 @@
+package eventstream
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/eventstream/dto"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStreamHandler_PushesEvents(t *testing.T) {
+	hub, srv := setupServer(t, 10, time.Minute)
+	messages := connect(t, srv, "", "")
+	waitSubscribed()
+
+	event := newEvent(domain.EventFailed, "email", domain.StatusNew)
+	event.Message = "connection refused"
+	hub.Publish(event)
+
+	msg := next(t, messages)
+	assert.Equal(t, "1", msg.id)
+	assert.Equal(t, "failed", msg.event)
+
+	var resp dto.StreamEventResponse
+	require.NoError(t, json.Unmarshal([]byte(msg.data), &resp))
+	assert.Equal(t, uint64(1), resp.ID)
+	assert.Equal(t, "failed", resp.Event)
+	assert.Equal(t, event.TaskID, resp.TaskID)
+	assert.Equal(t, "email", resp.Type)
+	assert.Equal(t, "NEW", resp.Status)
+	assert.Equal(t, 1, resp.Attempt)
+	assert.Equal(t, "connection refused", resp.Message)
+}
+
+func TestStreamHandler_FiltersByTypeAndStatus(t *testing.T) {
+	hub, srv := setupServer(t, 10, time.Minute)
+	messages := connect(t, srv, "?type=email,sms&status=PROCESSED", "")
+	waitSubscribed()
+
+	hub.Publish(newEvent(domain.EventProcessed, "push", domain.StatusProcessed))
+	hub.Publish(newEvent(domain.EventAcquired, "email", domain.StatusProcessing))
+	hub.Publish(newEvent(domain.EventProcessed, "sms", domain.StatusProcessed))
+
+	msg := next(t, messages)
+	assert.Equal(t, "3", msg.id)
+	assert.Equal(t, "processed", msg.event)
+}
+
+func TestStreamHandler_ResumesFromLastEventID(t *testing.T) {
+	hub, srv := setupServer(t, 3, time.Minute)
+	for range 5 {
+		hub.Publish(newEvent(domain.EventAcquired, "email", domain.StatusProcessing))
+	}
+
+	// Events 1 and 2 fell out of the buffer, 3 is the first one after the last received
+	messages := connect(t, srv, "", "2")
+	for _, id := range []string{"3", "4", "5"} {
+		assert.Equal(t, id, next(t, messages).id)
+	}
+
+	hub.Publish(newEvent(domain.EventProcessed, "email", domain.StatusProcessed))
+	assert.Equal(t, "6", next(t, messages).id)
+}
+
+func TestStreamHandler_SendsKeepAlivePings(t *testing.T) {
+	_, srv := setupServer(t, 10, 50*time.Millisecond)
+	messages := connect(t, srv, "", "")
+
+	select {
+	case msg := <-messages:
+		assert.Equal(t, "ping", msg.comment)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no keep-alive ping received")
+	}
+}
+
+func TestStreamHandler_EndsWhenHubCloses(t *testing.T) {
+	hub, srv := setupServer(t, 10, time.Minute)
+	messages := connect(t, srv, "", "")
+	waitSubscribed()
+
+	hub.Close()
+
+	select {
+	case _, ok := <-messages:
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream still open after the hub closed")
+	}
+}
+
+func TestStreamHandler_RejectsInvalidParameters(t *testing.T) {
+	_, srv := setupServer(t, 10, time.Minute)
+
+	tests := []struct {
+		name        string
+		query       string
+		lastEventID string
+	}{
+		{name: "unknown status", query: "?status=DONE"},
+		{name: "invalid Last-Event-ID", lastEventID: "abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, srv.URL+"/api/v1/tasks/events/stream"+tt.query, nil)
+			require.NoError(t, err)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+
+			resp, err := srv.Client().Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		})
+	}
+}
\ No newline at end of file
@@ -0,0 +1,81 @@
+package outboxsink
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/outboxsink"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRedisStreamSink_WritesMessageFields verifies a published message is
+// appended to the stream with its outbox ID, task ID, event and payload
+func TestRedisStreamSink_WritesMessageFields(t *testing.T) {
+	client := GetRedisClient(t)
+	stream := newStream(t, client)
+	ctx := context.Background()
+
+	message := &domain.OutboxMessage{
+		ID:      42,
+		TaskID:  uuid.New(),
+		Event:   domain.EventProcessed,
+		Payload: []byte(`{"status":"PROCESSED"}`),
+	}
+	sink := outboxsink.NewRedisStreamSink(client, stream, 0)
+	require.NoError(t, sink.Publish(ctx, message))
+
+	entries, err := client.XRange(ctx, stream, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	assert.Equal(t, map[string]any{
+		"id":      "42",
+		"task_id": message.TaskID.String(),
+		"event":   string(domain.EventProcessed),
+		"payload": `{"status":"PROCESSED"}`,
+	}, entries[0].Values)
+}
+
+// TestRedisStreamSink_TrimsToMaxLen verifies the stream is trimmed as messages
+// are appended. Trimming is approximate, so the stream keeps at least MaxLen
+// entries and drops the oldest ones once whole nodes are over the limit.
+func TestRedisStreamSink_TrimsToMaxLen(t *testing.T) {
+	client := GetRedisClient(t)
+	stream := newStream(t, client)
+	ctx := context.Background()
+
+	const (
+		maxLen    = 10
+		published = 500
+	)
+	sink := outboxsink.NewRedisStreamSink(client, stream, maxLen)
+	for i := 1; i <= published; i++ {
+		require.NoError(t, sink.Publish(ctx, &domain.OutboxMessage{
+			ID:      int64(i),
+			TaskID:  uuid.New(),
+			Event:   domain.EventCreated,
+			Payload: []byte(`{}`),
+		}))
+	}
+
+	length, err := client.XLen(ctx, stream).Result()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, length, int64(maxLen))
+	assert.Less(t, length, int64(published))
+
+	// The oldest messages were trimmed, the latest one is kept
+	first, err := client.XRangeN(ctx, stream, "-", "+", 1).Result()
+	require.NoError(t, err)
+	require.Len(t, first, 1)
+	assert.NotEqual(t, "1", first[0].Values["id"])
+
+	last, err := client.XRevRangeN(ctx, stream, "+", "-", 1).Result()
+	require.NoError(t, err)
+	require.Len(t, last, 1)
+	assert.Equal(t, strconv.Itoa(published), last[0].Values["id"])
+}
@@ -0,0 +1,32 @@
+package outboxsink
+
+import (
+	"context"
+	"testing"
+
+	"task-processor/internal/infrastructure/config"
+	rd "task-processor/internal/infrastructure/adapters/outbound/redis"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// GetRedisClient connects to the Redis of the application config
+func GetRedisClient(t *testing.T) *redis.Client {
+	cfg := config.GetConfig()
+
+	rdb, err := rd.NewRedisClient(cfg)
+	if err != nil {
+		t.Fatalf("Failed to create Redis client: %v", err)
+	}
+	t.Cleanup(func() { _ = rdb.Client().Close() })
+
+	return rdb.Client()
+}
+
+// newStream returns a stream name unique to the test and deletes the stream afterwards
+func newStream(t *testing.T, client *redis.Client) string {
+	stream := "outboxsink-test-" + uuid.NewString()
+	t.Cleanup(func() { _ = client.Del(context.Background(), stream).Err() })
+	return stream
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
     "time"
 
+	"task-processor/internal/infrastructure/shared/metrics"
 	"task-processor/internal/infrastructure/shared/middleware"
 
 	"github.com/redis/go-redis/v9"
@@ -18,7 +19,7 @@ func TestRedisRateLimiter_AllowedRequests(t *testing.T) {
 	defer redisClient.Close()
 
 	// Create limiter with 10 requests per second
-	limiter := middleware.NewRedisRateLimiter(redisClient, 10)
+	limiter := middleware.NewRedisRateLimiter(redisClient, 10, metrics.New())
 	handler := limiter.Middleware(http.HandlerFunc(TestHandler))
 
 	// Make 5 requests - all should pass
@@ -42,7 +43,7 @@ func TestRedisRateLimiter_RateLimitExceeded(t *testing.T) {
 	defer redisClient.Close()
 
 	// Limit 2 requests per second
-	limiter := middleware.NewRedisRateLimiter(redisClient, 2)
+	limiter := middleware.NewRedisRateLimiter(redisClient, 2, metrics.New())
 	handler := limiter.Middleware(http.HandlerFunc(TestHandler))
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -73,7 +74,7 @@ func TestRedisRateLimiter_DifferentIPs(t *testing.T) {
 	defer redisClient.Close()
 
 	// Limit 1 request per second
-	limiter := middleware.NewRedisRateLimiter(redisClient, 1)
+	limiter := middleware.NewRedisRateLimiter(redisClient, 1, metrics.New())
 	handler := limiter.Middleware(http.HandlerFunc(TestHandler))
 
 	// Request from first IP
@@ -100,7 +101,7 @@ func TestRedisRateLimiter_ResetAfterTime(t *testing.T) {
 	defer redisClient.Close()
 
 	// Limit 1 request per second
-	limiter := middleware.NewRedisRateLimiter(redisClient, 1)
+	limiter := middleware.NewRedisRateLimiter(redisClient, 1, metrics.New())
 	handler := limiter.Middleware(http.HandlerFunc(TestHandler))
 
 	req := httptest.NewRequest("GET", "/", nil)
@@ -133,7 +134,7 @@ func TestRedisRateLimiter_RedisFailure(t *testing.T) {
 	redisClient := redis.NewClient(invalidCfg)
 	defer redisClient.Close()
 
-	limiter := middleware.NewRedisRateLimiter(redisClient, 10)
+	limiter := middleware.NewRedisRateLimiter(redisClient, 10, metrics.New())
 	handler := limiter.Middleware(http.HandlerFunc(TestHandler))
 
 	// Request should pass (fail-open strategy) even with Redis connection issues
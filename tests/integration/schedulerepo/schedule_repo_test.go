@@ -0,0 +1,150 @@
+package schedulerepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/application/usecases/schedule/scheduler"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupStorage opens storage and closes it after the test
+func setupStorage(t *testing.T) *postgres.Storage {
+	storage, err := postgres.NewStorage(context.Background(), logger.GetLogger(), config.GetConfig())
+	require.NoError(t, err)
+	t.Cleanup(storage.Close)
+	return storage
+}
+
+// createSchedule inserts an interval schedule due at nextRunAt and deletes it after the test
+func createSchedule(t *testing.T, storage *postgres.Storage, nextRunAt time.Time) *domain.Schedule {
+	schedule := &domain.Schedule{
+		Name:          "schedulerepo-test-" + uuid.NewString(),
+		Interval:      time.Minute,
+		TaskType:      "schedulerepo-test",
+		Payload:       []byte(`{}`),
+		MisfirePolicy: domain.MisfireSkip,
+		Enabled:       true,
+		NextRunAt:     nextRunAt,
+	}
+	require.NoError(t, storage.ScheduleRepo.Create(context.Background(), schedule))
+	t.Cleanup(func() {
+		_ = storage.ScheduleRepo.Delete(context.Background(), schedule.ID)
+	})
+	return schedule
+}
+
+func TestScheduleRepo_CRUD(t *testing.T) {
+	ctx := context.Background()
+	storage := setupStorage(t)
+
+	created := createSchedule(t, storage, time.Now().Add(time.Hour).Truncate(time.Microsecond))
+	require.NotEqual(t, uuid.Nil, created.ID)
+
+	got, err := storage.ScheduleRepo.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.Name, got.Name)
+	assert.Equal(t, time.Minute, got.Interval)
+	assert.Empty(t, got.CronExpr)
+	assert.Nil(t, got.LastRunAt)
+
+	got.CronExpr = "@hourly"
+	got.Interval = 0
+	got.Enabled = false
+	require.NoError(t, storage.ScheduleRepo.Update(ctx, got))
+
+	updated, err := storage.ScheduleRepo.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "@hourly", updated.CronExpr)
+	assert.Zero(t, updated.Interval)
+	assert.False(t, updated.Enabled)
+
+	require.NoError(t, storage.ScheduleRepo.Delete(ctx, created.ID))
+	_, err = storage.ScheduleRepo.Get(ctx, created.ID)
+	assert.ErrorIs(t, err, domain.ErrScheduleNotFound)
+}
+
+func TestScheduleRepo_NameTaken(t *testing.T) {
+	storage := setupStorage(t)
+	existing := createSchedule(t, storage, time.Now().Add(time.Hour))
+
+	duplicate := *existing
+	err := storage.ScheduleRepo.Create(context.Background(), &duplicate)
+
+	assert.ErrorIs(t, err, domain.ErrScheduleNameTaken)
+}
+
+func TestScheduleRepo_ListDueAndMarkFired(t *testing.T) {
+	ctx := context.Background()
+	storage := setupStorage(t)
+
+	now := time.Now()
+	due := createSchedule(t, storage, now.Add(-time.Minute))
+	notDue := createSchedule(t, storage, now.Add(time.Hour))
+
+	schedules, err := storage.ScheduleRepo.ListDue(ctx, now, 1000)
+	require.NoError(t, err)
+	ids := make(map[uuid.UUID]bool, len(schedules))
+	for _, s := range schedules {
+		ids[s.ID] = true
+	}
+	assert.True(t, ids[due.ID])
+	assert.False(t, ids[notDue.ID])
+
+	require.NoError(t, storage.ScheduleRepo.MarkFired(ctx, due.ID, due.NextRunAt, now.Add(time.Minute)))
+
+	fired, err := storage.ScheduleRepo.Get(ctx, due.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fired.LastRunAt)
+	assert.WithinDuration(t, now.Add(time.Minute), fired.NextRunAt, time.Millisecond)
+}
+
+func TestAdvisoryLocker_SingleHolder(t *testing.T) {
+	ctx := context.Background()
+	storage := setupStorage(t)
+
+	held := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error, 1)
+
+	// First transaction takes the lock and keeps it until released
+	go func() {
+		done <- storage.TxManager.WithTransaction(ctx, func(ctx context.Context) error {
+			acquired, err := storage.Locker.TryLock(ctx, scheduler.LockKey)
+			close(held)
+			if err != nil {
+				return err
+			}
+			assert.True(t, acquired)
+			<-release
+			return nil
+		})
+	}()
+	<-held
+
+	err := storage.TxManager.WithTransaction(ctx, func(ctx context.Context) error {
+		acquired, err := storage.Locker.TryLock(ctx, scheduler.LockKey)
+		assert.False(t, acquired)
+		return err
+	})
+	require.NoError(t, err)
+
+	close(release)
+	require.NoError(t, <-done)
+
+	// Released on commit
+	err = storage.TxManager.WithTransaction(ctx, func(ctx context.Context) error {
+		acquired, err := storage.Locker.TryLock(ctx, scheduler.LockKey)
+		assert.True(t, acquired)
+		return err
+	})
+	require.NoError(t, err)
+}
\ No newline at end of file
@@ -18,7 +18,7 @@ func TestBatchCreateHandler_Success(t *testing.T) {
 	defer cleanup()
 	router := setupRouter(controller)
 
-	reqBody := dto.BatchCreateTasksRequest{Count: 5}
+	reqBody := newBatchCreateRequest(5)
 	body, _ := json.Marshal(reqBody)
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/batch-create", bytes.NewReader(body))
 	w := httptest.NewRecorder()
@@ -74,7 +74,7 @@ func TestBatchCreateHandler_InvalidCount(t *testing.T) {
 
 	for _, count := range invalidCounts {
 		// Prepare request with invalid count
-		reqBody := dto.BatchCreateTasksRequest{Count: count}
+		reqBody := newBatchCreateRequest(count)
 		body, _ := json.Marshal(reqBody)
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/batch-create", bytes.NewReader(body))
 		w := httptest.NewRecorder()
@@ -98,7 +98,7 @@ func TestBatchCreateHandler_LargeCount(t *testing.T) {
 	defer cleanup()
 	router := setupRouter(controller)
 
-	reqBody := dto.BatchCreateTasksRequest{Count: 50} 
+	reqBody := newBatchCreateRequest(50)
 	body, _ := json.Marshal(reqBody)
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/batch-create", bytes.NewReader(body))
 	w := httptest.NewRecorder()
@@ -123,3 +123,27 @@ func TestBatchCreateHandler_LargeCount(t *testing.T) {
 	// Assert that all 50 IDs were created
 	require.Len(t, resp.IDs, 50)
 }
+
+func TestBatchCreateHandler_MissingType(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	// Prepare request where one of the tasks has no type
+	reqBody := newBatchCreateRequest(2)
+	reqBody.Tasks[1].Type = ""
+	body, _ := json.Marshal(reqBody)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/batch-create", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	// Expect HTTP 400 Bad Request
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	err := json.NewDecoder(w.Body).Decode(&httpResp)
+	require.NoError(t, err)
+	require.False(t, httpResp.Success)
+	require.Contains(t, httpResp.Message, "Validation failed")
+}
@@ -0,0 +1,63 @@
+package taskcontroller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsHandler_Success(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	id := createDelayedTask(t, router)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/"+id+"/events", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&httpResp))
+	dataBytes, _ := json.Marshal(httpResp.Data)
+
+	var resp dto.TaskEventsResponse
+	require.NoError(t, json.Unmarshal(dataBytes, &resp))
+	require.Equal(t, id, resp.TaskID.String())
+	require.Len(t, resp.Events, 1)
+	require.Equal(t, string(domain.EventCreated), resp.Events[0].Type)
+}
+
+func TestEventsHandler_NotFound(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/"+uuid.NewString()+"/events", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestEventsHandler_InvalidID(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/not-a-uuid/events", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
\ No newline at end of file
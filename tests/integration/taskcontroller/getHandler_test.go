@@ -0,0 +1,65 @@
+package taskcontroller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHandler_Success(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	id := createDelayedTask(t, router)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/"+id, nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&httpResp))
+	dataBytes, _ := json.Marshal(httpResp.Data)
+
+	var resp dto.TaskResponse
+	require.NoError(t, json.Unmarshal(dataBytes, &resp))
+	require.Equal(t, id, resp.ID)
+	require.Equal(t, string(domain.StatusNew), resp.Status)
+	require.Zero(t, resp.Attempts)
+	require.False(t, resp.DeadLettered)
+	require.NotNil(t, resp.RunAt)
+}
+
+func TestGetHandler_NotFound(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/"+uuid.NewString(), nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestGetHandler_InvalidID(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/not-a-uuid", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
\ No newline at end of file
@@ -0,0 +1,63 @@
+package taskcontroller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListHandler_Success(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	id := createDelayedTask(t, router)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks?status=NEW&limit=500", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&httpResp))
+	dataBytes, _ := json.Marshal(httpResp.Data)
+
+	var resp dto.TaskListResponse
+	require.NoError(t, json.Unmarshal(dataBytes, &resp))
+
+	found := false
+	for _, task := range resp.Tasks {
+		require.Equal(t, "NEW", task.Status)
+		found = found || task.ID == id
+	}
+	require.True(t, found, "newest task is on the first page")
+}
+
+func TestListHandler_BadRequest(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	queries := []string{
+		"status=DONE",
+		"limit=0",
+		"limit=abc",
+		"created_from=yesterday",
+		"cursor=not-a-cursor",
+	}
+
+	for _, query := range queries {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks?"+query, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusBadRequest, w.Result().StatusCode, query)
+	}
+}
\ No newline at end of file
@@ -18,16 +18,15 @@ func TestProcessTasksHandler_Success(t *testing.T) {
 	router := setupRouter(controller)
 
 	// Pre-create tasks so there is something to process
-	ids, err := controller.TaskUseCases.Creator.CreateTasksBatch(ctx, 5)
+	ids, err := controller.TaskUseCases.Creator.CreateTasksBatch(ctx, newDomainBatchCreateRequest(5, 1.0))
 	require.NoError(t, err)
 	require.Len(t, ids, 5)
 
 	// Prepare a valid request payload
 	reqBody := dto.ProcessTasksRequest{
-		Limit:       5,
-		MinDelayMS:  10,
-		MaxDelayMS:  50,
-		SuccessRate: 1.0,
+		Limit:      5,
+		MinDelayMS: 10,
+		MaxDelayMS: 50,
 	}
 	body, _ := json.Marshal(reqBody)
 
@@ -90,11 +89,11 @@ func TestProcessTasksHandler_InvalidPayload(t *testing.T) {
 
 	// Prepare invalid payloads for different validation errors
 	invalidPayloads := []dto.ProcessTasksRequest{
-		{Limit: 0, MinDelayMS: 10, MaxDelayMS: 50, SuccessRate: 0.5},  // Limit < 1
-		{Limit: 5, MinDelayMS: -1, MaxDelayMS: 50, SuccessRate: 0.5},  // MinDelayMS < 0
-		{Limit: 5, MinDelayMS: 10, MaxDelayMS: -10, SuccessRate: 0.5}, // MaxDelayMS < 0
-		{Limit: 5, MinDelayMS: 10, MaxDelayMS: 50, SuccessRate: -0.1}, // SuccessRate < 0
-		{Limit: 5, MinDelayMS: 10, MaxDelayMS: 50, SuccessRate: 1.1},  // SuccessRate > 1
+		{Limit: 0, MinDelayMS: 10, MaxDelayMS: 50},  // Limit < 1
+		{Limit: 51, MinDelayMS: 10, MaxDelayMS: 50}, // Limit > 50
+		{Limit: 5, MinDelayMS: -1, MaxDelayMS: 50},  // MinDelayMS < 0
+		{Limit: 5, MinDelayMS: 10, MaxDelayMS: -10}, // MaxDelayMS < 0
+		{Limit: 5, MinDelayMS: 50, MaxDelayMS: 10},  // MaxDelayMS < MinDelayMS
 	}
 
 	for _, payload := range invalidPayloads {
@@ -123,17 +122,16 @@ func TestProcessTasksHandler_MultipleRequests(t *testing.T) {
 	router := setupRouter(controller)
 
 	// Pre-create tasks to be processed
-	ids, err := controller.TaskUseCases.Creator.CreateTasksBatch(ctx, 10)
+	ids, err := controller.TaskUseCases.Creator.CreateTasksBatch(ctx, newDomainBatchCreateRequest(10, 0.8))
 	require.NoError(t, err)
 	require.Len(t, ids, 10)
 
 	// Send multiple requests in a loop
 	for i := 0; i < 2; i++ {
 		reqBody := dto.ProcessTasksRequest{
-			Limit:       5,
-			MinDelayMS:  10,
-			MaxDelayMS:  50,
-			SuccessRate: 0.8,
+			Limit:      5,
+			MinDelayMS: 10,
+			MaxDelayMS: 50,
 		}
 		body, _ := json.Marshal(reqBody)
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/process", bytes.NewReader(body))
@@ -162,3 +160,46 @@ func TestProcessTasksHandler_MultipleRequests(t *testing.T) {
 		require.Equal(t, 5, resp.ProcessedCount)
 	}
 }
+
+
+func TestProcessTasksHandler_IncludeDetails(t *testing.T) {
+	controller, ctx, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	// Pre-create tasks that always fail so each one reports a handler failure
+	ids, err := controller.TaskUseCases.Creator.CreateTasksBatch(ctx, newDomainBatchCreateRequest(3, 0.0))
+	require.NoError(t, err)
+	require.Len(t, ids, 3)
+
+	body, _ := json.Marshal(dto.ProcessTasksRequest{Limit: 3, IncludeDetails: true})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/process", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	err = json.NewDecoder(w.Body).Decode(&httpResp)
+	require.NoError(t, err)
+	require.True(t, httpResp.Success)
+
+	dataBytes, err := json.Marshal(httpResp.Data)
+	require.NoError(t, err)
+
+	var resp dto.ProcessTasksResponse
+	err = json.Unmarshal(dataBytes, &resp)
+	require.NoError(t, err)
+
+	// Every processed task is described, handler failures carry their error
+	require.Len(t, resp.Details, resp.ProcessedCount+resp.ReleasedCount)
+	require.Equal(t, 0, resp.ErrorCount)
+	for _, detail := range resp.Details {
+		require.NotEmpty(t, detail.ID)
+		require.GreaterOrEqual(t, detail.Attempt, 1)
+		if detail.Outcome == "failed" || detail.Outcome == "dead_lettered" {
+			require.Equal(t, "FAILED", detail.Status)
+			require.NotEmpty(t, detail.Error)
+		}
+	}
+}
\ No newline at end of file
@@ -0,0 +1,100 @@
+package taskcontroller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeRun unwraps a processing run from the HTTP response wrapper
+func decodeRun(t *testing.T, w *httptest.ResponseRecorder) dto.ProcessingRunResponse {
+	var httpResp utils.HTTPResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&httpResp))
+	require.True(t, httpResp.Success)
+
+	dataBytes, err := json.Marshal(httpResp.Data)
+	require.NoError(t, err)
+
+	var run dto.ProcessingRunResponse
+	require.NoError(t, json.Unmarshal(dataBytes, &run))
+	return run
+}
+
+func TestProcessTasksHandler_AsyncRun(t *testing.T) {
+	controller, ctx, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	ids, err := controller.TaskUseCases.Creator.CreateTasksBatch(ctx, newDomainBatchCreateRequest(3, 1.0))
+	require.NoError(t, err)
+	require.Len(t, ids, 3)
+
+	body, _ := json.Marshal(dto.ProcessTasksRequest{Limit: 3, MinDelayMS: 10, MaxDelayMS: 50, Async: true})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/process", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	// The request returns before the batch is processed
+	require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+	started := decodeRun(t, w)
+	require.Equal(t, "RUNNING", started.Status)
+	require.Equal(t, "/api/v1/processing-runs/"+started.ID, w.Header().Get("Location"))
+
+	// Poll the run until it has finished
+	var run dto.ProcessingRunResponse
+	require.Eventually(t, func() bool {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/processing-runs/"+started.ID, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Result().StatusCode != http.StatusOK {
+			return false
+		}
+		run = decodeRun(t, w)
+		return run.Status != "RUNNING"
+	}, 10*time.Second, 50*time.Millisecond)
+
+	require.Equal(t, "COMPLETED", run.Status)
+	require.NotNil(t, run.FinishedAt)
+	require.Equal(t, 3, run.AcquiredCount)
+	require.Equal(t, run.AcquiredCount, run.ProcessedCount+run.ReleasedCount)
+	require.Equal(t, run.ProcessedCount, run.SuccessCount+run.FailedCount+run.ErrorCount)
+}
+
+func TestProcessTasksHandler_AsyncRejectsDetails(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	body, _ := json.Marshal(dto.ProcessTasksRequest{Limit: 3, Async: true, IncludeDetails: true})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/process", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
+
+func TestGetRunHandler_NotFound(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/processing-runs/"+uuid.NewString(), nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v1/processing-runs/not-a-uuid", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+}
\ No newline at end of file
@@ -0,0 +1,103 @@
+package taskcontroller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// createDelayedTask creates a simulated task due in one hour and returns its ID
+func createDelayedTask(t *testing.T, router http.Handler) string {
+	reqBody := newBatchCreateRequest(1)
+	reqBody.Tasks[0].DelayMS = int(time.Hour / time.Millisecond)
+	body, _ := json.Marshal(reqBody)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/batch-create", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&httpResp))
+	dataBytes, _ := json.Marshal(httpResp.Data)
+
+	var resp dto.BatchCreateTasksResponse
+	require.NoError(t, json.Unmarshal(dataBytes, &resp))
+	require.Len(t, resp.IDs, 1)
+
+	return resp.IDs[0]
+}
+
+func TestRescheduleHandler_Success(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	id := createDelayedTask(t, router)
+	runAt := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+
+	body, _ := json.Marshal(dto.RescheduleTaskRequest{RunAt: &runAt})
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/"+id+"/reschedule", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&httpResp))
+	dataBytes, _ := json.Marshal(httpResp.Data)
+
+	var resp dto.RescheduleTaskResponse
+	require.NoError(t, json.Unmarshal(dataBytes, &resp))
+	require.Equal(t, id, resp.ID)
+	require.True(t, runAt.Equal(resp.RunAt))
+}
+
+func TestRescheduleHandler_NotFound(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	body := []byte(`{"delay_ms": 1000}`)
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/"+uuid.NewString()+"/reschedule", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestRescheduleHandler_InvalidPayload(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	testCases := []struct {
+		name string
+		id   string
+		body string
+	}{
+		{"invalid id", "not-a-uuid", `{"delay_ms": 1000}`},
+		{"missing time", uuid.NewString(), `{}`},
+		{"run_at and delay", uuid.NewString(), `{"run_at": "2030-01-01T02:00:00Z", "delay_ms": 1000}`},
+		{"negative delay", uuid.NewString(), `{"delay_ms": -1}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks/"+tc.id+"/reschedule", bytes.NewReader([]byte(tc.body)))
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+			require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+		})
+	}
+}
\ No newline at end of file
@@ -2,15 +2,25 @@ package taskcontroller
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	taskProcessorPort "task-processor/internal/application/ports/inbound/tasksprocessor"
 	taskUseCases "task-processor/internal/application/usecases/task"
+	"task-processor/internal/application/usecases/task/backoff"
+	"task-processor/internal/domain"
 	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
 	"task-processor/internal/infrastructure/adapters/inbound/random"
 	"task-processor/internal/infrastructure/adapters/inbound/tasksprocessor"
+	"task-processor/internal/infrastructure/adapters/outbound/outboxsink"
 	"task-processor/internal/infrastructure/adapters/outbound/postgres"
 	"task-processor/internal/infrastructure/adapters/outbound/redis"
+	"task-processor/internal/infrastructure/adapters/outbound/taskhandler"
+	"task-processor/internal/infrastructure/adapters/outbound/webhook"
 	"task-processor/internal/infrastructure/config"
 	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
 	"task-processor/internal/infrastructure/shared/validator"
 	"testing"
 
@@ -41,14 +51,52 @@ func setupTestDependencies(t *testing.T) (*task.Controller, context.Context, fun
 	// Initialize worker pool
 	workerpool := workerpool.New(cfg.WorkerPool.MaxWorkers)
 
+	// Initialize task handlers
+	randomProvider := random.NewCryptoRandomProvider()
+	handlers := taskhandler.NewRegistry()
+	handlers.Register(taskhandler.SimulatedTaskType, taskhandler.NewSimulatedHandler(randomProvider))
+
 	// Initialize task use cases and concurrent processor
 	taskUseCases := taskUseCases.NewUseCases(
 		storage.TaskRepo, 
 		storage.FailedTaskRepo, 
+		storage.TaskEventRepo,
+		storage.TaskAttemptRepo,
+		storage.WebhookRepo,
+		storage.OutboxRepo,
+		storage.ProcessingRunRepo,
 		storage.TxManager, 
-		random.NewCryptoRandomProvider(),
+		storage.Locker,
+		randomProvider,
+		handlers,
+		webhook.NewHTTPSender(cfg.Webhook.Secret, cfg.Webhook.Timeout, cfg.Webhook.AllowedNetworks),
+		outboxsink.NewStdoutSink(io.Discard),
+		nil,
+		taskUseCases.Settings{
+			Lease: domain.Lease{
+				Owner:    "integration-test",
+				Duration: cfg.Lease.Duration,
+			},
+			ReaperBatchSize: cfg.Lease.ReaperBatchSize,
+			ReleaseTimeout:  cfg.Lease.ReleaseTimeout,
+			RetryBackoff: backoff.Config{
+				Base:       cfg.Retry.BackoffBase,
+				Multiplier: cfg.Retry.BackoffMultiplier,
+				Cap:        cfg.Retry.BackoffCap,
+				Jitter:     backoff.Jitter(cfg.Retry.BackoffJitter),
+			},
+			SweeperBatchSize: cfg.Sweeper.BatchSize,
+			StatsCacheTTL:    cfg.Stats.CacheTTL,
+			EventRetention:      cfg.TaskEvents.Retention,
+			EventPruneBatchSize: cfg.TaskEvents.PruneBatchSize,
+			Runs: taskUseCases.RunSettings{
+				StaleAfter: cfg.ProcessingRun.StaleAfter,
+				Retention:  cfg.ProcessingRun.Retention,
+				BatchSize:  cfg.ProcessingRun.BatchSize,
+			},
+		},
 	)
-	ccProcessor := tasksprocessor.NewConcurrentTasksProcessor(log, workerpool, taskUseCases)
+	ccProcessor := tasksprocessor.NewConcurrentTasksProcessor(log, workerpool, taskUseCases, metrics.New())
 
 	// Initialize controller
 	controller := task.NewController(validator, ccProcessor, taskUseCases)
@@ -68,4 +116,29 @@ func setupRouter(controller *task.Controller) http.Handler {
 	r := chi.NewRouter()
 	controller.RegisterRoutes(r)
 	return r
+}
+
+// newBatchCreateRequest builds an HTTP batch-create payload with count simulated tasks
+func newBatchCreateRequest(count int) dto.BatchCreateTasksRequest {
+	return newSimulatedBatchCreateRequest(count, 1.0)
+}
+
+// newSimulatedBatchCreateRequest builds count simulated tasks with the given success rate
+func newSimulatedBatchCreateRequest(count int, successRate float64) dto.BatchCreateTasksRequest {
+	payload, _ := json.Marshal(map[string]float64{"success_rate": successRate})
+
+	tasks := make([]dto.TaskInput, count)
+	for i := range tasks {
+		tasks[i] = dto.TaskInput{
+			Type:    taskhandler.SimulatedTaskType,
+			Payload: payload,
+		}
+	}
+	return dto.BatchCreateTasksRequest{Tasks: tasks}
+}
+
+// newDomainBatchCreateRequest builds a use case batch-create request with count simulated tasks
+func newDomainBatchCreateRequest(count int, successRate float64) *taskProcessorPort.BatchCreateTasksRequest {
+	req := newSimulatedBatchCreateRequest(count, successRate)
+	return req.ToDomainBatchCreate()
 }
\ No newline at end of file
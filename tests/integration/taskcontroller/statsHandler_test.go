@@ -0,0 +1,47 @@
+package taskcontroller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/task/dto"
+	"task-processor/internal/infrastructure/adapters/inbound/httpserver/utils"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsHandler_Success(t *testing.T) {
+	controller, _, cleanup := setupTestDependencies(t)
+	defer cleanup()
+	router := setupRouter(controller)
+
+	// A delayed task stays NEW, so the queue has at least one waiting task
+	createDelayedTask(t, router)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/stats", nil)
+	w := httptest.NewRecorder()
+
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var httpResp utils.HTTPResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&httpResp))
+	dataBytes, _ := json.Marshal(httpResp.Data)
+
+	var resp dto.QueueStatsResponse
+	require.NoError(t, json.Unmarshal(dataBytes, &resp))
+
+	for _, status := range []domain.TaskStatus{
+		domain.StatusNew, domain.StatusProcessing, domain.StatusProcessed, domain.StatusFailed,
+	} {
+		require.Contains(t, resp.Counts, string(status))
+	}
+	require.GreaterOrEqual(t, resp.Counts[string(domain.StatusNew)], 1)
+	require.Equal(t, resp.Counts[string(domain.StatusProcessing)], resp.Processing)
+	require.NotNil(t, resp.OldestNewCreatedAt)
+	require.GreaterOrEqual(t, resp.OldestNewAgeSeconds, 0.0)
+	require.False(t, resp.CollectedAt.IsZero())
+}
\ No newline at end of file
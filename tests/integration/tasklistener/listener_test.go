@@ -0,0 +1,88 @@
+package tasklistener
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupListener starts a listener on a fresh storage and waits until it is subscribed
+func setupListener(t *testing.T) (*postgres.Storage, *postgres.Listener) {
+	ctx := context.Background()
+	cfg := config.GetConfig()
+	log := logger.GetLogger()
+
+	storage, err := postgres.NewStorage(ctx, log, cfg)
+	require.NoError(t, err)
+
+	listener := postgres.NewListener(storage.Pool(), log, 100*time.Millisecond)
+	done := make(chan struct{})
+	go func() {
+		_ = listener.Run()
+		close(done)
+	}()
+
+	t.Cleanup(func() {
+		listener.Stop(nil)
+		<-done
+		storage.Close()
+	})
+
+	require.Eventually(t, listener.Connected, 2*time.Second, 10*time.Millisecond)
+	// Drain the wake-up sent on connect
+	select {
+	case <-listener.Wake():
+	case <-time.After(time.Second):
+	}
+
+	return storage, listener
+}
+
+func newTasks(count int) []*domain.Task {
+	tasks := make([]*domain.Task, count)
+	for i := range tasks {
+		tasks[i] = &domain.Task{Status: domain.StatusNew, Type: "simulated", Payload: []byte(`{}`)}
+	}
+	return tasks
+}
+
+// TestListener_WakesOnBatchCreate verifies BatchCreate notifies listening workers
+func TestListener_WakesOnBatchCreate(t *testing.T) {
+	storage, listener := setupListener(t)
+
+	_, err := storage.TaskRepo.BatchCreate(context.Background(), newTasks(3))
+	require.NoError(t, err)
+
+	select {
+	case <-listener.Wake():
+	case <-time.After(2 * time.Second):
+		t.Fatal("listener was not woken after BatchCreate")
+	}
+}
+
+// TestListener_NoWakeOnRollback verifies notifications are bound to the caller's transaction
+func TestListener_NoWakeOnRollback(t *testing.T) {
+	storage, listener := setupListener(t)
+
+	err := storage.TxManager.WithTransaction(context.Background(), func(ctx context.Context) error {
+		if _, err := storage.TaskRepo.BatchCreate(ctx, newTasks(1)); err != nil {
+			return err
+		}
+		return assert.AnError
+	})
+	require.ErrorIs(t, err, assert.AnError)
+
+	select {
+	case <-listener.Wake():
+		t.Fatal("listener was woken by a rolled back transaction")
+	case <-time.After(300 * time.Millisecond):
+	}
+}
\ No newline at end of file
@@ -0,0 +1,46 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTaskAttempts_ListInRecordedOrder verifies attempts are kept per task, oldest first
+func TestTaskAttempts_ListInRecordedOrder(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+
+	taskID := uuid.New()
+	started := time.Now().UTC().Truncate(time.Millisecond)
+	attempts := []*domain.TaskAttempt{
+		{
+			TaskID: taskID, Number: 1, Owner: testLease.Owner, Outcome: domain.AttemptFailed,
+			ErrorMessage: "smtp unavailable", StartedAt: started, FinishedAt: started.Add(time.Second),
+		},
+		{
+			TaskID: taskID, Number: 2, Owner: testLease.Owner, Outcome: domain.AttemptSucceeded,
+			StartedAt: started.Add(time.Minute), FinishedAt: started.Add(time.Minute + 500*time.Millisecond),
+		},
+	}
+	for _, attempt := range attempts {
+		require.NoError(t, storage.TaskAttemptRepo.Create(ctx, attempt))
+		require.NotZero(t, attempt.ID)
+	}
+
+	got, err := storage.TaskAttemptRepo.ListByTask(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+
+	require.Equal(t, domain.AttemptFailed, got[0].Outcome)
+	require.Equal(t, "smtp unavailable", got[0].ErrorMessage)
+	require.Equal(t, time.Second, got[0].Duration())
+	require.Equal(t, 2, got[1].Number)
+	require.Empty(t, got[1].ErrorMessage)
+	require.Equal(t, 500*time.Millisecond, got[1].Duration())
+}
\ No newline at end of file
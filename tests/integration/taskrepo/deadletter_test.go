@@ -0,0 +1,94 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deadLetter moves an existing task into failed_tasks the way the processor does
+func deadLetter(t *testing.T, ctx context.Context, id uuid.UUID) {
+	storage, repo := setupTaskRepo(t)
+
+	leaseTask(t, repo, id, testLease, testLease.Duration)
+	task, err := repo.Get(ctx, id)
+	require.NoError(t, err)
+	task.Status = domain.StatusFailed
+	task.Attempts = task.MaxAttempts
+	task.ErrorMessage = "deadletter-test failure"
+
+	err = storage.TxManager.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := repo.Delete(ctx, id, testLease); err != nil {
+			return err
+		}
+		return storage.FailedTaskRepo.Create(ctx, task)
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = storage.FailedTaskRepo.Remove(context.Background(), id)
+	})
+}
+
+// TestFailedTasks_RequeueRestoresTask verifies a dead-lettered task returns as NEW under its ID
+func TestFailedTasks_RequeueRestoresTask(t *testing.T) {
+	storage, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := insertTasks(t, repo, newTask(0))
+	deadLetter(t, ctx, ids[0])
+
+	_, err := repo.Get(ctx, ids[0])
+	require.ErrorIs(t, err, domain.ErrTaskNotFound)
+
+	dead, err := storage.FailedTaskRepo.Get(ctx, ids[0])
+	require.NoError(t, err)
+	require.NotNil(t, dead.MovedAt)
+
+	err = storage.TxManager.WithTransaction(ctx, func(ctx context.Context) error {
+		task, err := storage.FailedTaskRepo.Remove(ctx, ids[0])
+		if err != nil {
+			return err
+		}
+		return repo.Restore(ctx, []*domain.Task{task})
+	})
+	require.NoError(t, err)
+
+	restored, err := repo.Get(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusNew, restored.Status)
+	assert.Zero(t, restored.Attempts)
+	assert.True(t, dead.CreatedAt.Equal(restored.CreatedAt))
+
+	_, err = storage.FailedTaskRepo.Get(ctx, ids[0])
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+}
+
+// TestFailedTasks_RemoveMatching verifies bulk removal honours the filter and the limit
+func TestFailedTasks_RemoveMatching(t *testing.T) {
+	storage, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	taskType, tasks := newTypedTasks(3)
+	ids := insertTasks(t, repo, tasks...)
+	for _, id := range ids {
+		deadLetter(t, ctx, id)
+	}
+
+	listed, err := storage.FailedTaskRepo.List(ctx, domain.TaskFilter{Type: taskType, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, listed, 3)
+
+	removed, err := storage.FailedTaskRepo.RemoveMatching(ctx, domain.TaskFilter{Type: taskType, Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, removed, 2)
+
+	left, err := storage.FailedTaskRepo.List(ctx, domain.TaskFilter{Type: taskType, Limit: 10})
+	require.NoError(t, err)
+	assert.Len(t, left, 1)
+}
\ No newline at end of file
@@ -0,0 +1,102 @@
+package taskrepo
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOutcome_RequiresLease verifies a worker whose task was reclaimed by
+// another instance can neither record its outcome nor dead-letter it
+func TestOutcome_RequiresLease(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority)
+	reclaimedBy := domain.Lease{Owner: "taskrepo-other-worker", Duration: time.Minute}
+	leaseTask(t, repo, ids[0], reclaimedBy, time.Minute)
+
+	assert.ErrorIs(t, repo.MarkAsProcessed(ctx, ids[0], testLease, json.RawMessage(`{}`)), domain.ErrLeaseLost)
+	assert.ErrorIs(t, repo.MarkAsFailed(ctx, ids[0], testLease, "late failure", time.Second), domain.ErrLeaseLost)
+	assert.ErrorIs(t, repo.Delete(ctx, ids[0], testLease), domain.ErrLeaseLost)
+
+	task, err := repo.Get(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusProcessing, task.Status)
+	assert.Equal(t, reclaimedBy.Owner, task.LockedBy)
+
+	require.NoError(t, repo.MarkAsProcessed(ctx, ids[0], reclaimedBy, json.RawMessage(`{}`)))
+
+	// Finished, the task is no longer leased by anyone
+	assert.ErrorIs(t, repo.MarkAsFailed(ctx, ids[0], reclaimedBy, "late failure", time.Second), domain.ErrLeaseLost)
+}
+
+// TestExtendLease_OnlyByOwner verifies the lease holder can prolong its lease
+// while anyone else gets domain.ErrLeaseLost and leaves it untouched
+func TestExtendLease_OnlyByOwner(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority)
+	leaseTask(t, repo, ids[0], testLease, time.Second)
+
+	before, err := repo.Get(ctx, ids[0])
+	require.NoError(t, err)
+	require.NotNil(t, before.LockedUntil)
+
+	other := domain.Lease{Owner: "taskrepo-other-worker", Duration: time.Hour}
+	assert.ErrorIs(t, repo.ExtendLease(ctx, ids[0], other), domain.ErrLeaseLost)
+
+	unchanged, err := repo.Get(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, testLease.Owner, unchanged.LockedBy)
+	assert.True(t, before.LockedUntil.Equal(*unchanged.LockedUntil))
+
+	require.NoError(t, repo.ExtendLease(ctx, ids[0], testLease))
+
+	extended, err := repo.Get(ctx, ids[0])
+	require.NoError(t, err)
+	assert.True(t, extended.LockedUntil.After(before.LockedUntil.Add(30*time.Second)))
+}
+
+// TestReleaseExpiredLeases_FailsTaskKeepingAttempt verifies a task whose lease
+// expired goes back to FAILED with the consumed attempt kept and its retry
+// postponed, while a task with a live lease stays with its owner
+func TestReleaseExpiredLeases_FailsTaskKeepingAttempt(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority, basePriority)
+	expired, live := ids[0], ids[1]
+	leaseTask(t, repo, expired, testLease, -time.Second)
+	leaseTask(t, repo, live, testLease, time.Minute)
+
+	retryAfter := func(attempts int) time.Duration { return time.Duration(attempts) * time.Hour }
+	released, err := repo.ReleaseExpiredLeases(ctx, 1000, retryAfter)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, released, 1)
+
+	task, err := repo.Get(ctx, expired)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusFailed, task.Status)
+	assert.Equal(t, 1, task.Attempts)
+	assert.Empty(t, task.LockedBy)
+	assert.Nil(t, task.LockedUntil)
+	assert.Contains(t, task.ErrorMessage, testLease.Owner)
+	// Retried after the backoff of a failed attempt, not right away
+	assert.WithinDuration(t, time.Now().Add(time.Hour), task.NextAttemptAt, 5*time.Minute)
+
+	// The worker that lost the lease can no longer record its outcome
+	assert.ErrorIs(t, repo.MarkAsProcessed(ctx, expired, testLease, json.RawMessage(`{}`)), domain.ErrLeaseLost)
+
+	still, err := repo.Get(ctx, live)
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusProcessing, still.Status)
+	assert.Equal(t, testLease.Owner, still.LockedBy)
+}
\ No newline at end of file
@@ -0,0 +1,93 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTypedTasks builds count NEW tasks of a type unique to the calling test
+func newTypedTasks(count int) (string, []*domain.Task) {
+	taskType := "taskrepo-list-" + uuid.NewString()[:8]
+	tasks := make([]*domain.Task, count)
+	for i := range tasks {
+		tasks[i] = newTask(0)
+		tasks[i].Type = taskType
+	}
+	return taskType, tasks
+}
+
+// TestList_KeysetPagination walks all pages and verifies every task is returned once, newest first
+func TestList_KeysetPagination(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	taskType, tasks := newTypedTasks(5)
+	// Tasks of one batch share created_at, so the id tie-breaker is exercised too
+	ids := insertTasks(t, repo, tasks...)
+
+	seen := make(map[uuid.UUID]bool)
+	filter := domain.TaskFilter{Type: taskType, Limit: 2}
+	var previous *domain.Task
+
+	for {
+		page, err := repo.List(ctx, filter)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		for _, task := range page {
+			assert.False(t, seen[task.ID], "task returned twice")
+			seen[task.ID] = true
+
+			if previous != nil {
+				assert.False(t, task.CreatedAt.After(previous.CreatedAt), "not ordered by created_at")
+			}
+			previous = task
+		}
+		last := page[len(page)-1]
+		filter.After = &domain.TaskCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	assert.Len(t, seen, len(ids))
+}
+
+// TestList_Filters verifies status, created_at range and error substring filters
+func TestList_Filters(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	taskType, tasks := newTypedTasks(2)
+	ids := insertTasks(t, repo, tasks...)
+	leaseTask(t, repo, ids[0], testLease, testLease.Duration)
+	require.NoError(t, repo.MarkAsFailed(ctx, ids[0], testLease, "Upstream 50% TIMEOUT", time.Hour))
+
+	failed, err := repo.List(ctx, domain.TaskFilter{
+		Type:     taskType,
+		Statuses: []domain.TaskStatus{domain.StatusFailed},
+		Limit:    10,
+	})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, ids[0], failed[0].ID)
+
+	// LIKE wildcards in the search text are matched literally
+	matched, err := repo.List(ctx, domain.TaskFilter{Type: taskType, ErrorContains: "50% timeout", Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, matched, 1)
+
+	unmatched, err := repo.List(ctx, domain.TaskFilter{Type: taskType, ErrorContains: "5_%", Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, unmatched)
+
+	future := time.Now().Add(time.Hour)
+	none, err := repo.List(ctx, domain.TaskFilter{Type: taskType, CreatedFrom: &future, Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, none)
+}
\ No newline at end of file
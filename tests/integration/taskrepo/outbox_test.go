@@ -0,0 +1,123 @@
+package taskrepo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOutbox_AppendFollowsTransaction verifies messages exist only once their
+// transaction commits and are claimed in write order
+func TestOutbox_AppendFollowsTransaction(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+	repo := storage.OutboxRepo
+	taskID := uuid.New()
+
+	rollback := errors.New("rollback")
+	err := storage.TxManager.WithTransaction(ctx, func(ctx context.Context) error {
+		require.NoError(t, repo.Append(ctx, newOutboxMessages(taskID, domain.EventCreated)))
+		return rollback
+	})
+	require.ErrorIs(t, err, rollback)
+	require.Empty(t, claimForTask(t, ctx, taskID))
+
+	messages := newOutboxMessages(taskID, domain.EventCreated, domain.EventProcessed)
+	err = storage.TxManager.WithTransaction(ctx, func(ctx context.Context) error {
+		return repo.Append(ctx, messages)
+	})
+	require.NoError(t, err)
+	require.Less(t, messages[0].ID, messages[1].ID)
+
+	pending := claimForTask(t, ctx, taskID)
+	require.Len(t, pending, 2)
+	require.Equal(t, domain.EventCreated, pending[0].Event)
+	require.Equal(t, domain.EventProcessed, pending[1].Event)
+
+	require.NoError(t, repo.MarkPublished(ctx, []int64{messages[0].ID, messages[1].ID}))
+	require.Empty(t, claimForTask(t, ctx, taskID))
+}
+
+// TestOutbox_ClaimWaitsForEarlierClaim verifies a held claim blocks the next
+// one until its messages are published or released
+func TestOutbox_ClaimWaitsForEarlierClaim(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+	repo := storage.OutboxRepo
+	taskID := uuid.New()
+
+	require.NoError(t, repo.Append(ctx, newOutboxMessages(taskID, domain.EventCreated, domain.EventProcessed)))
+
+	claimed, err := repo.Claim(ctx, 10000, time.Minute)
+	require.NoError(t, err)
+	require.NotEmpty(t, claimed)
+
+	again, err := repo.Claim(ctx, 10000, time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, again)
+
+	ids := make([]int64, len(claimed))
+	for i, message := range claimed {
+		ids[i] = message.ID
+	}
+	require.NoError(t, repo.Release(ctx, ids))
+
+	again, err = repo.Claim(ctx, 10000, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, again, len(claimed))
+	require.NoError(t, repo.MarkPublished(ctx, ids))
+}
+
+// TestOutbox_DeletePublishedBefore verifies only published messages past the cutoff are deleted
+func TestOutbox_DeletePublishedBefore(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+	repo := storage.OutboxRepo
+	taskID := uuid.New()
+
+	messages := newOutboxMessages(taskID, domain.EventCreated, domain.EventProcessed)
+	require.NoError(t, repo.Append(ctx, messages))
+	require.NoError(t, repo.MarkPublished(ctx, []int64{messages[0].ID}))
+
+	_, err := repo.DeletePublishedBefore(ctx, time.Now().Add(time.Minute), 10000)
+	require.NoError(t, err)
+
+	pending := claimForTask(t, ctx, taskID)
+	require.Len(t, pending, 1)
+	require.Equal(t, messages[1].ID, pending[0].ID)
+	require.NoError(t, repo.MarkPublished(ctx, []int64{messages[1].ID}))
+}
+
+func newOutboxMessages(taskID uuid.UUID, events ...domain.TaskEventType) []*domain.OutboxMessage {
+	messages := make([]*domain.OutboxMessage, len(events))
+	for i, event := range events {
+		messages[i] = &domain.OutboxMessage{TaskID: taskID, Event: event, Payload: []byte(`{}`)}
+	}
+	return messages
+}
+
+// claimForTask claims the pending outbox messages, releases them again and
+// returns those of one task
+func claimForTask(t *testing.T, ctx context.Context, taskID uuid.UUID) []*domain.OutboxMessage {
+	storage, _ := setupTaskRepo(t)
+
+	messages, err := storage.OutboxRepo.Claim(ctx, 10000, time.Minute)
+	require.NoError(t, err)
+
+	ids := make([]int64, len(messages))
+	var own []*domain.OutboxMessage
+	for i, message := range messages {
+		ids[i] = message.ID
+		if message.TaskID == taskID {
+			own = append(own, message)
+		}
+	}
+	require.NoError(t, storage.OutboxRepo.Release(ctx, ids))
+	return own
+}
\ No newline at end of file
@@ -0,0 +1,105 @@
+package taskrepo
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAcquireTasks_HigherPriorityFirst verifies tasks are served by priority, not creation order
+func TestAcquireTasks_HigherPriorityFirst(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority, basePriority+2, basePriority+1)
+
+	var acquired []uuid.UUID
+	for range ids {
+		tasks, err := repo.AcquireTasks(ctx, 1, testLease)
+		require.NoError(t, err)
+		require.Len(t, tasks, 1)
+		acquired = append(acquired, tasks[0].ID)
+	}
+
+	assert.Equal(t, []uuid.UUID{ids[1], ids[2], ids[0]}, acquired)
+}
+
+// TestAcquireTasks_AgingPreventsStarvation verifies a long-waiting task overtakes
+// newer tasks whose priority advantage is smaller than its waiting time
+func TestAcquireTasks_AgingPreventsStarvation(t *testing.T) {
+	storage, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority, basePriority+1)
+
+	// Pretend the low priority task was enqueued two aging intervals ago
+	_, err := storage.Pool().Exec(ctx, `
+		UPDATE tasks
+		SET created_at = created_at - 2 * $2::interval,
+		    effective_at = effective_at - 2 * $2::interval
+		WHERE id = $1
+	`, ids[0], testAging)
+	require.NoError(t, err)
+
+	tasks, err := repo.AcquireTasks(ctx, 1, testLease)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, ids[0], tasks[0].ID)
+}
+
+// TestAcquireTasks_ConcurrentSkipLocked verifies concurrent acquirers never get
+// the same task and together take exactly the highest priority tasks
+func TestAcquireTasks_ConcurrentSkipLocked(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	const workers = 10
+
+	priorities := make([]int, 0, 2*workers)
+	for range workers {
+		priorities = append(priorities, basePriority)
+	}
+	for range workers {
+		priorities = append(priorities, basePriority+10)
+	}
+	ids := createTasks(t, repo, priorities...)
+	highPriority := make(map[uuid.UUID]bool, workers)
+	for _, id := range ids[workers:] {
+		highPriority[id] = true
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		acquired = make(map[uuid.UUID]int)
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tasks, err := repo.AcquireTasks(ctx, 1, testLease)
+			if !assert.NoError(t, err) {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, task := range tasks {
+				acquired[task.ID]++
+				assert.Equal(t, domain.StatusProcessing, task.Status)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, acquired, workers)
+	for id, count := range acquired {
+		assert.Equal(t, 1, count, "task %s acquired more than once", id)
+		assert.True(t, highPriority[id], "task %s is not among the highest priorities", id)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,131 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFailStale_FailsOnlyRunsWithoutProgress verifies a running run that was
+// not refreshed within the stale period is failed, while a fresh running run
+// and a finished one are left as they are
+func TestFailStale_FailsOnlyRunsWithoutProgress(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+	repo := storage.ProcessingRunRepo
+
+	stale, fresh, finished := newRun(t, storage.Pool()), newRun(t, storage.Pool()), newRun(t, storage.Pool())
+	require.NoError(t, repo.Create(ctx, stale))
+	require.NoError(t, repo.Create(ctx, fresh))
+	require.NoError(t, repo.Create(ctx, finished))
+	require.NoError(t, repo.Finish(ctx, finished.ID, domain.RunCompleted, ""))
+
+	_, err := storage.Pool().Exec(ctx, `
+		UPDATE processing_runs SET updated_at = NOW() - INTERVAL '1 hour' WHERE id = ANY($1)
+	`, []uuid.UUID{stale.ID, finished.ID})
+	require.NoError(t, err)
+
+	// Progress keeps the fresh run alive even though it started long ago
+	_, err = storage.Pool().Exec(ctx, `
+		UPDATE processing_runs SET created_at = NOW() - INTERVAL '1 hour' WHERE id = $1
+	`, fresh.ID)
+	require.NoError(t, err)
+	require.NoError(t, repo.AddProgress(ctx, fresh.ID, domain.RunCounters{}))
+
+	failed, err := repo.FailStale(ctx, time.Minute, 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, failed, 1)
+
+	got, err := repo.Get(ctx, stale.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RunFailed, got.Status)
+	assert.Contains(t, got.ErrorMessage, stale.Owner)
+	assert.NotNil(t, got.FinishedAt)
+
+	got, err = repo.Get(ctx, fresh.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RunRunning, got.Status)
+
+	got, err = repo.Get(ctx, finished.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RunCompleted, got.Status)
+}
+
+// newRun builds a running run and deletes it after the test
+func newRun(t *testing.T, pool *pgxpool.Pool) *domain.ProcessingRun {
+	run := &domain.ProcessingRun{
+		ID:     uuid.New(),
+		Status: domain.RunRunning,
+		Owner:  testLease.Owner,
+		Limit:  10,
+	}
+	t.Cleanup(func() {
+		_, _ = pool.Exec(context.Background(), `DELETE FROM processing_runs WHERE id = $1`, run.ID)
+	})
+	return run
+}
+
+// TestFinish_KeepsRunFailedAsOrphaned verifies a run failed as orphaned is not
+// turned back into a completed one when its instance finishes it late
+func TestFinish_KeepsRunFailedAsOrphaned(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+	repo := storage.ProcessingRunRepo
+
+	run := newRun(t, storage.Pool())
+	require.NoError(t, repo.Create(ctx, run))
+	_, err := storage.Pool().Exec(ctx, `
+		UPDATE processing_runs SET updated_at = NOW() - INTERVAL '1 hour' WHERE id = $1
+	`, run.ID)
+	require.NoError(t, err)
+
+	_, err = repo.FailStale(ctx, time.Minute, 1000)
+	require.NoError(t, err)
+
+	err = repo.Finish(ctx, run.ID, domain.RunCompleted, "")
+	assert.ErrorIs(t, err, domain.ErrRunFinished)
+
+	got, err := repo.Get(ctx, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RunFailed, got.Status)
+
+	err = repo.Finish(ctx, uuid.New(), domain.RunCompleted, "")
+	assert.ErrorIs(t, err, domain.ErrRunNotFound)
+}
+
+// TestDeleteFinishedBefore_KeepsRecentAndRunningRuns verifies only runs
+// finished before the cutoff are deleted
+func TestDeleteFinishedBefore_KeepsRecentAndRunningRuns(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+	repo := storage.ProcessingRunRepo
+
+	old, recent, running := newRun(t, storage.Pool()), newRun(t, storage.Pool()), newRun(t, storage.Pool())
+	for _, run := range []*domain.ProcessingRun{old, recent, running} {
+		require.NoError(t, repo.Create(ctx, run))
+	}
+	require.NoError(t, repo.Finish(ctx, old.ID, domain.RunCompleted, ""))
+	require.NoError(t, repo.Finish(ctx, recent.ID, domain.RunCompleted, ""))
+	_, err := storage.Pool().Exec(ctx, `
+		UPDATE processing_runs SET finished_at = NOW() - INTERVAL '2 hours' WHERE id = $1
+	`, old.ID)
+	require.NoError(t, err)
+
+	deleted, err := repo.DeleteFinishedBefore(ctx, time.Now().Add(-time.Hour), 1000)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, deleted, 1)
+
+	_, err = repo.Get(ctx, old.ID)
+	assert.ErrorIs(t, err, domain.ErrRunNotFound)
+	for _, id := range []uuid.UUID{recent.ID, running.ID} {
+		_, err := repo.Get(ctx, id)
+		assert.NoError(t, err)
+	}
+}
\ No newline at end of file
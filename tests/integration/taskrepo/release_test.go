@@ -0,0 +1,38 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReleaseLease_ReturnsTaskWithoutAttempt verifies a released task is NEW
+// again with the attempt given back, and that only the lease holder can release it
+func TestReleaseLease_ReturnsTaskWithoutAttempt(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority+400)
+	tasks, err := repo.AcquireTasks(ctx, 1, testLease)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, ids[0], tasks[0].ID)
+	require.Equal(t, 1, tasks[0].Attempts)
+
+	other := domain.Lease{Owner: "someone-else", Duration: testLease.Duration}
+	assert.ErrorIs(t, repo.ReleaseLease(ctx, ids[0], other), domain.ErrLeaseLost)
+
+	require.NoError(t, repo.ReleaseLease(ctx, ids[0], testLease))
+
+	released, err := repo.Get(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatusNew, released.Status)
+	assert.Equal(t, 0, released.Attempts)
+
+	// No longer leased, a second release has nothing to give back
+	assert.ErrorIs(t, repo.ReleaseLease(ctx, ids[0], testLease), domain.ErrLeaseLost)
+}
\ No newline at end of file
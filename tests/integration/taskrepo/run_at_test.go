@@ -0,0 +1,64 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAcquireTasks_SkipsTasksNotYetDue verifies delayed tasks are not acquired before run_at
+func TestAcquireTasks_SkipsTasksNotYetDue(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	runAt := time.Now().Add(time.Hour)
+	delayed := newTask(basePriority + 100)
+	delayed.RunAt = &runAt
+	ready := newTask(basePriority + 1)
+
+	ids := insertTasks(t, repo, delayed, ready)
+
+	tasks, err := repo.AcquireTasks(ctx, 1, testLease)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, ids[1], tasks[0].ID)
+}
+
+// TestReschedule_MakesTaskDue verifies a delayed task can be pulled forward
+func TestReschedule_MakesTaskDue(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	runAt := time.Now().Add(time.Hour)
+	delayed := newTask(basePriority + 200)
+	delayed.RunAt = &runAt
+	ids := insertTasks(t, repo, delayed)
+
+	require.NoError(t, repo.Reschedule(ctx, ids[0], time.Now().Add(-time.Second)))
+
+	tasks, err := repo.AcquireTasks(ctx, 1, testLease)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, ids[0], tasks[0].ID)
+	require.NotNil(t, tasks[0].RunAt)
+}
+
+// TestReschedule_RejectsProcessingTask verifies only pending tasks can be rescheduled
+func TestReschedule_RejectsProcessingTask(t *testing.T) {
+	_, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority+300)
+	tasks, err := repo.AcquireTasks(ctx, 1, testLease)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, ids[0], tasks[0].ID)
+
+	err = repo.Reschedule(ctx, ids[0], time.Now().Add(time.Hour))
+	assert.ErrorIs(t, err, domain.ErrTaskNotPending)
+}
\ No newline at end of file
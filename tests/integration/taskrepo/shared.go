@@ -0,0 +1,94 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/application/ports/outbound/persistence/taskrepo"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/logger"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// Priorities far above the API range, so tasks left in the shared database
+// by other tests never compete with the ones created here
+const (
+	basePriority = 1_000_000
+	testAging    = time.Hour
+)
+
+var testLease = domain.Lease{Owner: "taskrepo-integration-test", Duration: time.Minute}
+
+// testRepo is the repository under test along with the pool used to clean up after it
+type testRepo struct {
+	taskrepo.TaskRepository
+	pool *pgxpool.Pool
+}
+
+// setupTaskRepo opens storage and returns a repository with a known aging interval
+func setupTaskRepo(t *testing.T) (*postgres.Storage, *testRepo) {
+	cfg := config.GetConfig()
+	log := logger.GetLogger()
+
+	storage, err := postgres.NewStorage(context.Background(), log, cfg)
+	require.NoError(t, err)
+	t.Cleanup(storage.Close)
+
+	return storage, &testRepo{
+		TaskRepository: postgres.NewTaskRepo(storage.Pool(), testAging),
+		pool:           storage.Pool(),
+	}
+}
+
+// createTasks inserts tasks with the given priorities and deletes them after the test
+func createTasks(t *testing.T, repo *testRepo, priorities ...int) []uuid.UUID {
+	tasks := make([]*domain.Task, len(priorities))
+	for i, priority := range priorities {
+		tasks[i] = newTask(priority)
+	}
+	return insertTasks(t, repo, tasks...)
+}
+
+// newTask builds a NEW test task with the given priority
+func newTask(priority int) *domain.Task {
+	return &domain.Task{
+		Status:   domain.StatusNew,
+		Type:     "taskrepo-test",
+		Payload:  []byte(`{}`),
+		Priority: priority,
+	}
+}
+
+// insertTasks inserts the tasks and deletes them after the test
+func insertTasks(t *testing.T, repo *testRepo, tasks ...*domain.Task) []uuid.UUID {
+	ctx := context.Background()
+
+	ids, err := repo.BatchCreate(ctx, tasks)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = repo.pool.Exec(ctx, `DELETE FROM tasks WHERE id = ANY($1)`, ids)
+	})
+
+	return ids
+}
+
+// leaseTask puts a task in PROCESSING under lease, expiring after expiresIn
+// (in the past when negative), as if it had been acquired
+func leaseTask(t *testing.T, repo *testRepo, id uuid.UUID, lease domain.Lease, expiresIn time.Duration) {
+	_, err := repo.pool.Exec(context.Background(), `
+		UPDATE tasks
+		SET status = $1,
+		    attempts = attempts + 1,
+		    locked_by = $2,
+		    locked_until = NOW() + $3::interval
+		WHERE id = $4
+	`, domain.StatusProcessing, lease.Owner, expiresIn, id)
+	require.NoError(t, err)
+}
\ No newline at end of file
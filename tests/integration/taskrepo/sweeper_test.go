@@ -0,0 +1,61 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDeleteExhausted_RemovesOnlyExhaustedFailedTasks verifies FAILED tasks
+// without attempts left are deleted and returned, while failed tasks that can
+// still be retried and tasks in other statuses stay in the queue
+func TestDeleteExhausted_RemovesOnlyExhaustedFailedTasks(t *testing.T) {
+	storage, repo := setupTaskRepo(t)
+	ctx := context.Background()
+
+	ids := createTasks(t, repo, basePriority, basePriority, basePriority)
+	exhausted, retryable, leased := ids[0], ids[1], ids[2]
+
+	_, err := storage.Pool().Exec(ctx, `
+		UPDATE tasks
+		SET status = $1,
+		    attempts = CASE WHEN id = $2 THEN max_attempts ELSE max_attempts - 1 END
+		WHERE id = ANY($3)
+	`, domain.StatusFailed, exhausted, []uuid.UUID{exhausted, retryable})
+	require.NoError(t, err)
+	// Out of attempts too, but still running its last one
+	leaseTask(t, repo, leased, testLease, testLease.Duration)
+	_, err = storage.Pool().Exec(ctx, `UPDATE tasks SET attempts = max_attempts WHERE id = $1`, leased)
+	require.NoError(t, err)
+
+	removed, err := repo.DeleteExhausted(ctx, 1000)
+	require.NoError(t, err)
+
+	removedIDs := make([]uuid.UUID, len(removed))
+	for i, task := range removed {
+		removedIDs[i] = task.ID
+	}
+	require.Contains(t, removedIDs, exhausted)
+	assert.NotContains(t, removedIDs, retryable)
+	assert.NotContains(t, removedIDs, leased)
+
+	for _, task := range removed {
+		if task.ID == exhausted {
+			assert.Equal(t, domain.StatusFailed, task.Status)
+			assert.Equal(t, task.MaxAttempts, task.Attempts)
+		}
+	}
+
+	_, err = repo.Get(ctx, exhausted)
+	assert.ErrorIs(t, err, domain.ErrTaskNotFound)
+
+	for _, id := range []uuid.UUID{retryable, leased} {
+		_, err := repo.Get(ctx, id)
+		assert.NoError(t, err)
+	}
+}
\ No newline at end of file
@@ -0,0 +1,66 @@
+package taskrepo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhookDeliveries_ClaimAndRetry verifies a claimed delivery is hidden for the
+// lease, and becomes due again once a failure is recorded without delay
+func TestWebhookDeliveries_ClaimAndRetry(t *testing.T) {
+	storage, _ := setupTaskRepo(t)
+	ctx := context.Background()
+	repo := storage.WebhookRepo
+
+	delivery := &domain.WebhookDelivery{
+		TaskID:  uuid.New(),
+		Event:   domain.WebhookTaskProcessed,
+		URL:     "https://example.com/hook",
+		Payload: []byte(`{"event":"task.processed"}`),
+	}
+	require.NoError(t, repo.Create(ctx, delivery))
+	require.NotZero(t, delivery.ID)
+	t.Cleanup(func() {
+		_ = repo.MarkAbandoned(context.Background(), delivery.ID, 0, "test cleanup")
+	})
+
+	claimed := claimByID(t, ctx, delivery.ID)
+	require.NotNil(t, claimed)
+	require.Equal(t, 1, claimed.Attempts)
+	require.JSONEq(t, `{"event":"task.processed"}`, string(claimed.Payload))
+
+	// Leased to us, so a second claim does not see it
+	require.Nil(t, claimByID(t, ctx, delivery.ID))
+
+	require.NoError(t, repo.MarkFailed(ctx, delivery.ID, 503, "unexpected status 503", 0))
+
+	retried := claimByID(t, ctx, delivery.ID)
+	require.NotNil(t, retried)
+	require.Equal(t, 2, retried.Attempts)
+	require.Equal(t, 503, retried.LastStatusCode)
+	require.Equal(t, "unexpected status 503", retried.LastError)
+
+	require.NoError(t, repo.MarkDelivered(ctx, delivery.ID, 200))
+	require.NoError(t, repo.MarkFailed(ctx, delivery.ID, 0, "ignored", 0))
+	require.Nil(t, claimByID(t, ctx, delivery.ID))
+}
+
+// claimByID claims due deliveries and returns the one with the given ID, if claimed
+func claimByID(t *testing.T, ctx context.Context, id int64) *domain.WebhookDelivery {
+	storage, _ := setupTaskRepo(t)
+
+	deliveries, err := storage.WebhookRepo.ClaimDue(ctx, 1000, time.Hour)
+	require.NoError(t, err)
+	for _, delivery := range deliveries {
+		if delivery.ID == id {
+			return delivery
+		}
+	}
+	return nil
+}
\ No newline at end of file
@@ -0,0 +1,181 @@
+package taskshutdown
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+
+	taskUseCases "task-processor/internal/application/usecases/task"
+	"task-processor/internal/domain"
+	"task-processor/internal/infrastructure/adapters/inbound/random"
+	"task-processor/internal/infrastructure/adapters/inbound/tasksprocessor"
+	"task-processor/internal/infrastructure/adapters/inbound/worker"
+	"task-processor/internal/infrastructure/adapters/outbound/outboxsink"
+	"task-processor/internal/infrastructure/adapters/outbound/postgres"
+	"task-processor/internal/infrastructure/adapters/outbound/taskhandler"
+	"task-processor/internal/infrastructure/adapters/outbound/webhook"
+	"task-processor/internal/infrastructure/config"
+	"task-processor/internal/infrastructure/shared/lifecycle"
+	"task-processor/internal/infrastructure/shared/logger"
+	"task-processor/internal/infrastructure/shared/metrics"
+
+	"github.com/gammazero/workerpool"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// slowTaskType is handled by slowHandler
+	slowTaskType = "shutdown-test"
+	// Priority far above the API range, so the worker acquires these tasks first
+	basePriority = 2_000_000
+	// releaseTimeout bounds returning interrupted tasks to the queue
+	releaseTimeout = 5 * time.Second
+)
+
+var testLease = domain.Lease{Owner: "shutdown-integration-test", Duration: time.Minute}
+
+// slowHandler signals when a task starts and then runs for its duration,
+// or until interrupted
+type slowHandler struct {
+	duration time.Duration
+	started  chan struct{}
+}
+
+func (h *slowHandler) Handle(ctx context.Context, _ *domain.Task) (json.RawMessage, error) {
+	h.started <- struct{}{}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(h.duration):
+		return json.RawMessage(`{}`), nil
+	}
+}
+
+// shutdownApp holds the pieces of the application involved in shutdown
+type shutdownApp struct {
+	storage   *postgres.Storage
+	processor *tasksprocessor.ConcurrentTasksProcessor
+	handler   *slowHandler
+	batchSize int
+}
+
+// setupApp builds storage, use cases and a processor running slow tasks of the given duration
+func setupApp(t *testing.T, taskDuration time.Duration, batchSize int) *shutdownApp {
+	ctx := context.Background()
+	cfg := config.GetConfig()
+	log := logger.GetLogger()
+
+	storage, err := postgres.NewStorage(ctx, log, cfg)
+	require.NoError(t, err)
+	t.Cleanup(storage.Close)
+
+	wp := workerpool.New(batchSize)
+	t.Cleanup(wp.StopWait)
+
+	handler := &slowHandler{duration: taskDuration, started: make(chan struct{}, batchSize)}
+	handlers := taskhandler.NewRegistry()
+	handlers.Register(slowTaskType, handler)
+
+	useCases := taskUseCases.NewUseCases(
+		storage.TaskRepo,
+		storage.FailedTaskRepo,
+		storage.TaskEventRepo,
+		storage.TaskAttemptRepo,
+		storage.WebhookRepo,
+		storage.OutboxRepo,
+		storage.ProcessingRunRepo,
+		storage.TxManager,
+		storage.Locker,
+		random.NewCryptoRandomProvider(),
+		handlers,
+		webhook.NewHTTPSender(cfg.Webhook.Secret, cfg.Webhook.Timeout, cfg.Webhook.AllowedNetworks),
+		outboxsink.NewStdoutSink(io.Discard),
+		nil,
+		taskUseCases.Settings{
+			Lease:          testLease,
+			ReleaseTimeout: releaseTimeout,
+		},
+	)
+
+	return &shutdownApp{
+		storage:   storage,
+		processor: tasksprocessor.NewConcurrentTasksProcessor(log, wp, useCases, metrics.New()),
+		handler:   handler,
+		batchSize: batchSize,
+	}
+}
+
+// createTasks inserts count slow tasks and deletes them after the test
+func (a *shutdownApp) createTasks(t *testing.T, count int) []uuid.UUID {
+	ctx := context.Background()
+
+	tasks := make([]*domain.Task, count)
+	for i := range tasks {
+		tasks[i] = &domain.Task{
+			Status:   domain.StatusNew,
+			Type:     slowTaskType,
+			Payload:  []byte(`{}`),
+			Priority: basePriority,
+		}
+	}
+
+	ids, err := a.storage.TaskRepo.BatchCreate(ctx, tasks)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = a.storage.Pool().Exec(ctx, `DELETE FROM tasks WHERE id = ANY($1)`, ids)
+	})
+
+	return ids
+}
+
+// run starts the worker in the shutdown sequence main uses and returns
+// a channel receiving the result of the group once it stops
+func (a *shutdownApp) run(drainTimeout time.Duration) <-chan error {
+	log := logger.GetLogger()
+
+	signalCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	g := lifecycle.NewGroup(signalCtx, stop, func() {
+		a.processor.Drain(drainTimeout, releaseTimeout)
+	})
+
+	w := worker.NewWorker(log, a.processor, config.Worker{
+		BatchSize:      a.batchSize,
+		PollInterval:   50 * time.Millisecond,
+		MaxIdleBackoff: 100 * time.Millisecond,
+	}, nil)
+	g.Add(w.Run, w.Stop)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- g.Run()
+	}()
+	return done
+}
+
+// waitStarted waits until count tasks have reached the handler
+func (a *shutdownApp) waitStarted(t *testing.T, count int) {
+	for range count {
+		select {
+		case <-a.handler.started:
+		case <-time.After(10 * time.Second):
+			t.Fatal("tasks did not start")
+		}
+	}
+}
+
+// waitStopped waits for the group to stop after shutdown
+func waitStopped(t *testing.T, done <-chan error) {
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(15 * time.Second):
+		t.Fatal("application did not stop")
+	}
+}
\ No newline at end of file
@@ -0,0 +1,53 @@
+package taskshutdown
+
+import (
+	"context"
+	"syscall"
+	"testing"
+	"time"
+
+	"task-processor/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSIGTERM_FinishesTasksInFlight verifies tasks running when SIGTERM arrives
+// complete when they fit within the drain timeout
+func TestSIGTERM_FinishesTasksInFlight(t *testing.T) {
+	app := setupApp(t, 300*time.Millisecond, 3)
+	ids := app.createTasks(t, 3)
+
+	done := app.run(5 * time.Second)
+	app.waitStarted(t, len(ids))
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+	waitStopped(t, done)
+
+	for _, id := range ids {
+		task, err := app.storage.TaskRepo.Get(context.Background(), id)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusProcessed, task.Status)
+		assert.Equal(t, 1, task.Attempts)
+	}
+}
+
+// TestSIGTERM_ReleasesTasksAfterDrainTimeout verifies tasks still running when
+// the drain timeout expires go back to the queue without counting the attempt
+func TestSIGTERM_ReleasesTasksAfterDrainTimeout(t *testing.T) {
+	app := setupApp(t, time.Minute, 3)
+	ids := app.createTasks(t, 3)
+
+	done := app.run(200 * time.Millisecond)
+	app.waitStarted(t, len(ids))
+
+	require.NoError(t, syscall.Kill(syscall.Getpid(), syscall.SIGTERM))
+	waitStopped(t, done)
+
+	for _, id := range ids {
+		task, err := app.storage.TaskRepo.Get(context.Background(), id)
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusNew, task.Status)
+		assert.Equal(t, 0, task.Attempts)
+	}
+}
\ No newline at end of file